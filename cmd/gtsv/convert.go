@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/yagi5/gtsv"
+)
+
+// convert runs convert command.
+func convert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	to := fs.String("to", "", "output format, csv or jsonl (required)")
+	schemaFile := fs.String("schema", "", "schema file, used to validate rows and type values of jsonl")
+	header := fs.Bool("header", true, "the first row of file is header")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: gtsv convert -to=csv|jsonl [-schema schema.tsv] [-header=false] [file]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if fs.NArg() > 1 {
+		fs.Usage()
+		return exitError
+	}
+
+	var conv func(io.Writer, io.Reader, ...gtsv.Option) error
+	switch *to {
+	case "csv":
+		conv = gtsv.ToCSV
+	case "jsonl":
+		conv = gtsv.ToJSONL
+	default:
+		fmt.Fprintf(stderr, "gtsv: unknown format %q\n", *to)
+		return exitError
+	}
+
+	var opts []gtsv.Option
+	if *header {
+		opts = append(opts, gtsv.WithHeader())
+	}
+	if *schemaFile != "" {
+		schema, err := readSchema(*schemaFile)
+		if err != nil {
+			fmt.Fprintf(stderr, "gtsv: %s\n", err)
+			return exitError
+		}
+		opts = append(opts, gtsv.WithSchema(schema))
+	}
+
+	file := "-"
+	if fs.NArg() == 1 {
+		file = fs.Arg(0)
+	}
+	f, err := open(file, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "gtsv: %s\n", err)
+		return exitError
+	}
+	defer f.Close()
+
+	out := &outWriter{w: stdout}
+	bw := bufio.NewWriter(out)
+	err = conv(bw, f, opts...)
+	if ferr := bw.Flush(); err == nil {
+		err = ferr // rows before the error are written too
+	}
+	if out.err != nil {
+		fmt.Fprintf(stderr, "gtsv: %s\n", out.err) // output failed, not the data
+		return exitError
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, newReport(file, err))
+		return exitInvalid
+	}
+	return exitOK
+}
+
+// outWriter keeps the error of w, to tell output errors from data errors.
+type outWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (o *outWriter) Write(p []byte) (int, error) {
+	n, err := o.w.Write(p)
+	if err != nil && o.err == nil {
+		o.err = err
+	}
+	return n, err
+}
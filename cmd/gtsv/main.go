@@ -0,0 +1,73 @@
+// Command gtsv checks and converts TSV files with gtsv.Reader.
+//
+// Usage:
+//
+//	gtsv validate -schema schema.tsv [-header=false] [-format=text|json] file...
+//	gtsv convert -to=csv|jsonl [-schema schema.tsv] [-header=false] [file]
+//
+// validate reads files and prints every row which doesn't match the schema.
+// A file which can't be opened is reported to stderr, and the other files are still validated.
+// The schema file format is described in `gtsv.ReadSchema()` .
+//
+// convert writes file as CSV or JSON Lines to stdout, like `gtsv.ToCSV()` and `gtsv.ToJSONL()` .
+// With schema, rows are validated and values of JSON Lines are typed.
+// Without file, it reads stdin.
+//
+// File "-" means stdin.
+// Exit status is 0 if all files are valid, 1 if any error is found, and 2 if the command failed.
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// Exit status.
+const (
+	exitOK      = 0
+	exitInvalid = 1
+	exitError   = 2
+)
+
+const usage = `Usage: gtsv <command> [flags] file...
+
+Commands:
+  validate  validate TSV files against a schema
+  convert   convert TSV file into CSV or JSON Lines
+
+Run "gtsv <command> -h" for flags of the command.
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command with args, and returns exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitError
+	}
+
+	switch args[0] {
+	case "validate":
+		return validate(args[1:], stdin, stdout, stderr)
+	case "convert":
+		return convert(args[1:], stdin, stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return exitOK
+	}
+	fmt.Fprintf(stderr, "gtsv: unknown command %q\n\n%s", args[0], usage)
+	return exitError
+}
+
+// open opens file, or returns stdin if file is "-".
+func open(file string, stdin io.Reader) (io.ReadCloser, error) {
+	if file == "-" {
+		return ioutil.NopCloser(stdin), nil
+	}
+	return os.Open(file)
+}
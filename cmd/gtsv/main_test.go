@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSchema = "id\tuint\tmin=1\n" +
+	"name\tstring\tmaxlen=5\n" +
+	"plan\tenum\tvalues=free,pro\n"
+
+// writeFiles writes files into temporary directory, and returns their paths and the function to remove them.
+func writeFiles(t *testing.T, files map[string]string) (map[string]string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "gtsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	paths := map[string]string{}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			cleanup()
+			t.Fatal(err)
+		}
+		paths[name] = path
+	}
+	return paths, cleanup
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		status int
+	}{
+		{name: "no command", args: nil, status: exitError},
+		{name: "unknown command", args: []string{"lint"}, status: exitError},
+		{name: "help", args: []string{"help"}, status: exitOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if status := run(tt.args, nil, &stdout, &stderr); status != tt.status {
+				t.Fatalf("exit status check failed expected: %d, actual: %d", tt.status, status)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	paths, cleanup := writeFiles(t, map[string]string{
+		"schema.tsv": testSchema,
+		"valid.tsv":  "id\tname\tplan\n1\tbob\tfree\n2\tcarol\tpro\n",
+		"invalid.tsv": "id\tname\tplan\n" +
+			"0\tbob\tfree\n" +
+			"2\tcarol\tpro\n" +
+			"3\tdave\tenterprise\n" +
+			"x\teve\tfree\n" +
+			"5\tfrank\n",
+	})
+	defer cleanup()
+
+	tests := []struct {
+		name   string
+		args   []string
+		stdin  string
+		status int
+		output string
+	}{
+		{
+			name:   "valid",
+			args:   []string{"-schema", paths["schema.tsv"], paths["valid.tsv"]},
+			status: exitOK,
+		},
+		{
+			name:   "invalid",
+			args:   []string{"-schema", paths["schema.tsv"], paths["valid.tsv"], paths["invalid.tsv"]},
+			status: exitInvalid,
+			output: paths["invalid.tsv"] + ":2: col #1 (id): violates min: 0 is less than 1\n" +
+				paths["invalid.tsv"] + ":4: col #3 (plan): violates values: \"enterprise\" is not one of [\"free\" \"pro\"]\n" +
+				paths["invalid.tsv"] + ":5: col #1 (id): strconv.ParseUint: parsing \"x\": invalid syntax\n" +
+				paths["invalid.tsv"] + ":6: col #3 (plan): expected 3 columns, but got 2\n",
+		},
+		{
+			name:   "stdin without header",
+			args:   []string{"-schema", paths["schema.tsv"], "-header=false", "-"},
+			stdin:  "1\tbob\tfree\n2\tgeorge\tpro\n",
+			status: exitInvalid,
+			output: "-:2: col #2 (name): violates max length: 6 characters is longer than 5\n",
+		},
+		{
+			name:   "no schema",
+			args:   []string{paths["valid.tsv"]},
+			status: exitError,
+		},
+		{
+			name:   "no file",
+			args:   []string{"-schema", paths["schema.tsv"], paths["valid.tsv"] + ".missing"},
+			status: exitError,
+		},
+		{
+			name:   "no file with other files",
+			args:   []string{"-schema", paths["schema.tsv"], paths["valid.tsv"] + ".missing", paths["invalid.tsv"]},
+			status: exitError,
+			output: paths["invalid.tsv"] + ":2: col #1 (id): violates min: 0 is less than 1\n" +
+				paths["invalid.tsv"] + ":4: col #3 (plan): violates values: \"enterprise\" is not one of [\"free\" \"pro\"]\n" +
+				paths["invalid.tsv"] + ":5: col #1 (id): strconv.ParseUint: parsing \"x\": invalid syntax\n" +
+				paths["invalid.tsv"] + ":6: col #3 (plan): expected 3 columns, but got 2\n",
+		},
+		{
+			name:   "unknown format",
+			args:   []string{"-schema", paths["schema.tsv"], "-format=xml", paths["valid.tsv"]},
+			status: exitError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			args := append([]string{"validate"}, tt.args...)
+			status := run(args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.status {
+				t.Fatalf("exit status check failed expected: %d, actual: %d, stderr: %s", tt.status, status, stderr.String())
+			}
+			if stdout.String() != tt.output {
+				t.Fatalf("output check failed expected: %q, actual: %q", tt.output, stdout.String())
+			}
+		})
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	paths, cleanup := writeFiles(t, map[string]string{
+		"schema.tsv":  testSchema,
+		"invalid.tsv": "id\tname\tplan\n1\tbob\tfree\n0\tcarol\tpro\n",
+	})
+	defer cleanup()
+
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"validate", "--schema", paths["schema.tsv"], "--format=json", paths["invalid.tsv"]}, nil, &stdout, &stderr)
+	if status != exitInvalid {
+		t.Fatalf("exit status check failed: %d, stderr: %s", status, stderr.String())
+	}
+
+	var reports []report
+	if err := json.Unmarshal(stdout.Bytes(), &reports); err != nil {
+		t.Fatalf("output is not JSON: %s", err)
+	}
+	expected := []report{{
+		File:   paths["invalid.tsv"],
+		Line:   3,
+		Col:    1,
+		Column: "id",
+		Kind:   "constraint violated",
+		Cause:  "violates min: 0 is less than 1",
+		Raw:    "0",
+	}}
+	if !reflect.DeepEqual(expected, reports) {
+		t.Fatalf("returned value check failed expected: %+v, actual: %+v", expected, reports)
+	}
+}
+
+func TestValidateJSONOpenError(t *testing.T) {
+	paths, cleanup := writeFiles(t, map[string]string{
+		"schema.tsv":  testSchema,
+		"invalid.tsv": "id\tname\tplan\n1\tbob\tfree\n0\tcarol\tpro\n",
+	})
+	defer cleanup()
+
+	var stdout, stderr bytes.Buffer
+	missing := paths["invalid.tsv"] + ".missing"
+	status := run([]string{"validate", "-schema", paths["schema.tsv"], "-format=json", missing, paths["invalid.tsv"]}, nil, &stdout, &stderr)
+	if status != exitError {
+		t.Fatalf("exit status check failed: %d, stderr: %s", status, stderr.String())
+	}
+	if !strings.Contains(stderr.String(), missing) {
+		t.Fatalf("open error is not reported: %q", stderr.String())
+	}
+
+	var reports []report
+	if err := json.Unmarshal(stdout.Bytes(), &reports); err != nil {
+		t.Fatalf("output is not JSON: %s", err)
+	}
+	if len(reports) != 1 || reports[0].File != paths["invalid.tsv"] || reports[0].Line != 3 {
+		t.Fatalf("invalid reports %+v", reports)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	paths, cleanup := writeFiles(t, map[string]string{
+		"schema.tsv": testSchema,
+		"users.tsv":  "id\tname\tplan\n1\tbob\tfree\n2\tcarol\\tc\tpro\n",
+	})
+	defer cleanup()
+
+	tests := []struct {
+		name   string
+		args   []string
+		stdin  string
+		status int
+		output string
+	}{
+		{
+			name:   "csv",
+			args:   []string{"-to=csv", paths["users.tsv"]},
+			status: exitOK,
+			output: "id,name,plan\n1,bob,free\n2,carol\tc,pro\n",
+		},
+		{
+			name:   "jsonl",
+			args:   []string{"-to=jsonl", paths["users.tsv"]},
+			status: exitOK,
+			output: `{"id":"1","name":"bob","plan":"free"}` + "\n" + `{"id":"2","name":"carol\tc","plan":"pro"}` + "\n",
+		},
+		{
+			name:   "jsonl with schema from stdin",
+			args:   []string{"-to=jsonl", "-schema", paths["schema.tsv"], "-header=false"},
+			stdin:  "1\tbob\tfree\n",
+			status: exitOK,
+			output: `{"id":1,"name":"bob","plan":"free"}` + "\n",
+		},
+		{
+			name:   "invalid",
+			args:   []string{"-to=jsonl", "-schema", paths["schema.tsv"], "-"},
+			stdin:  "id\tname\tplan\n1\tbob\tfree\n0\tcarol\tpro\n",
+			status: exitInvalid,
+			output: `{"id":1,"name":"bob","plan":"free"}` + "\n",
+		},
+		{
+			name:   "unknown format",
+			args:   []string{"-to=xml", paths["users.tsv"]},
+			status: exitError,
+		},
+		{
+			name:   "too many files",
+			args:   []string{"-to=csv", paths["users.tsv"], paths["users.tsv"]},
+			status: exitError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			args := append([]string{"convert"}, tt.args...)
+			status := run(args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.status {
+				t.Fatalf("exit status check failed expected: %d, actual: %d, stderr: %s", tt.status, status, stderr.String())
+			}
+			if stdout.String() != tt.output {
+				t.Fatalf("output check failed expected: %q, actual: %q", tt.output, stdout.String())
+			}
+		})
+	}
+}
+
+type failWriter struct{}
+
+func (failWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestConvertOutputError(t *testing.T) {
+	var stderr bytes.Buffer
+	status := run([]string{"convert", "-to=csv", "-"}, strings.NewReader("id\n1\n"), failWriter{}, &stderr)
+	if status != exitError {
+		t.Fatalf("exit status check failed expected: %d, actual: %d, stderr: %s", exitError, status, stderr.String())
+	}
+}
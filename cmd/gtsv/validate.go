@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/yagi5/gtsv"
+)
+
+// report is an error found by validate.
+type report struct {
+	File   string `json:"file"`
+	Line   int    `json:"line,omitempty"`
+	Col    int    `json:"col,omitempty"`
+	Column string `json:"column,omitempty"`
+	Kind   string `json:"kind,omitempty"`
+	Cause  string `json:"cause"`
+	Raw    string `json:"raw,omitempty"`
+}
+
+// newReport returns report of err which happened in file.
+func newReport(file string, err error) report {
+	var e gtsv.Error
+	if !errors.As(err, &e) {
+		return report{File: file, Cause: err.Error()}
+	}
+
+	r := report{
+		File:   file,
+		Line:   e.Position().Line,
+		Col:    e.Col(),
+		Column: e.Name(),
+		Raw:    string(e.Raw()),
+	}
+	if kind := e.Kind(); kind != nil {
+		r.Kind = kind.Error()
+		r.Cause = kind.Error()
+	}
+	if cause := errors.Unwrap(err); cause != nil {
+		r.Cause = cause.Error()
+	}
+	return r
+}
+
+// String returns r in the form of "file:line: col #n (name): cause".
+func (r report) String() string {
+	if r.Line == 0 {
+		return fmt.Sprintf("%s: %s", r.File, r.Cause)
+	}
+	s := fmt.Sprintf("%s:%d: col #%d", r.File, r.Line, r.Col)
+	if r.Column != "" {
+		s += fmt.Sprintf(" (%s)", r.Column)
+	}
+	return s + ": " + r.Cause
+}
+
+// validate runs validate command.
+func validate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	schemaFile := fs.String("schema", "", "schema file (required)")
+	header := fs.Bool("header", true, "the first row of files is header")
+	format := fs.String("format", "text", "output format, text or json")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: gtsv validate -schema schema.tsv [-header=false] [-format=text|json] file...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if *schemaFile == "" || fs.NArg() == 0 {
+		fs.Usage()
+		return exitError
+	}
+	if *format != "text" && *format != "json" {
+		fmt.Fprintf(stderr, "gtsv: unknown format %q\n", *format)
+		return exitError
+	}
+
+	schema, err := readSchema(*schemaFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "gtsv: %s\n", err)
+		return exitError
+	}
+
+	reports := []report{}
+	emit := func(r report) {
+		if *format == "json" {
+			reports = append(reports, r)
+			return
+		}
+		fmt.Fprintln(stdout, r)
+	}
+
+	status := exitOK
+	failed := false
+	for _, file := range fs.Args() {
+		n, err := validateFile(file, stdin, schema, *header, emit)
+		if err != nil {
+			fmt.Fprintf(stderr, "gtsv: %s\n", err)
+			failed = true // remaining files are still checked
+			continue
+		}
+		if n > 0 {
+			status = exitInvalid
+		}
+	}
+
+	if *format == "json" {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(reports); err != nil {
+			fmt.Fprintf(stderr, "gtsv: %s\n", err)
+			return exitError
+		}
+	}
+	if failed {
+		return exitError
+	}
+	return status
+}
+
+// readSchema reads schema from file.
+func readSchema(file string) (gtsv.Schema, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return gtsv.Schema{}, err
+	}
+	defer f.Close()
+
+	return gtsv.ReadSchema(f)
+}
+
+// validateFile validates file against schema, and emits errors found.
+// It returns the number of errors, or error if file couldn't be opened.
+func validateFile(file string, stdin io.Reader, schema gtsv.Schema, header bool, emit func(report)) (int, error) {
+	f, err := open(file, stdin)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	opts := []gtsv.Option{gtsv.WithSchema(schema), gtsv.WithLenient(0)}
+	if header {
+		opts = append(opts, gtsv.WithHeader())
+	}
+	gr := gtsv.New(f, opts...)
+
+	n := 0
+	flush := func() {
+		for _, err := range gr.Errors()[n:] {
+			emit(newReport(file, err))
+			n++
+		}
+	}
+	for gr.Next() {
+		gr.SkipRest() // columns are checked by the schema
+		flush()
+	}
+	flush()
+
+	if err := gr.Error(); err != nil {
+		emit(newReport(file, err)) // reading was aborted
+		n++
+	}
+	return n, nil
+}
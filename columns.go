@@ -0,0 +1,29 @@
+package gtsv
+
+// checkColumns returns current row has the number of columns passed to `WithColumns()` .
+// If not, the row is marked as failed.
+func (gr *Reader) checkColumns() bool {
+	if gr.columns <= 0 {
+		return true
+	}
+	return gr.failColumnCount(gr.columns)
+}
+
+// failColumnCount returns current row has expected number of columns.
+// If not, it fails with ErrMissingColumn at the first missing column,
+// or ErrExtraColumn at the first extra column.
+func (gr *Reader) failColumnCount(expected int) bool {
+	actual := len(gr.fields)
+	cause := &ColumnCountError{Expected: expected, Actual: actual}
+	switch {
+	case actual < expected:
+		gr.col = actual + 1
+		gr.fail(ErrMissingColumn, nil, cause)
+		return false
+	case actual > expected:
+		gr.col = expected + 1
+		gr.fail(ErrExtraColumn, gr.fields[expected], cause)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestColumns(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		opts     []Option
+		result   [][]string
+		kind     error
+		row      int
+		col      int
+		expected int
+		actual   int
+	}{
+		{
+			name:   "valid",
+			tsv:    "a\tb\nc\td\n",
+			opts:   []Option{WithColumns(2)},
+			result: [][]string{{"a", "b"}, {"c", "d"}},
+		},
+		{
+			name:     "missing",
+			tsv:      "a\tb\nc\n",
+			opts:     []Option{WithColumns(2)},
+			result:   [][]string{{"a", "b"}},
+			kind:     ErrMissingColumn,
+			row:      2,
+			col:      2,
+			expected: 2,
+			actual:   1,
+		},
+		{
+			name:     "extra",
+			tsv:      "a\tb\tc\n",
+			opts:     []Option{WithColumns(2)},
+			kind:     ErrExtraColumn,
+			row:      1,
+			col:      3,
+			expected: 2,
+			actual:   3,
+		},
+		{
+			name:   "header is not checked",
+			tsv:    "x\ny\tz\n",
+			opts:   []Option{WithColumns(2), WithHeader()},
+			result: [][]string{{"y", "z"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			var ret [][]string
+			for gr.Next() {
+				ret = append(ret, gr.Strings())
+			}
+
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+			if tt.kind == nil {
+				if err := gr.Error(); err != nil {
+					t.Fatalf("unexpected error %s", err)
+				}
+				return
+			}
+
+			var e Error
+			var ce *ColumnCountError
+			if !errors.As(gr.Error(), &e) || !errors.Is(gr.Error(), tt.kind) || e.Row() != tt.row || e.Col() != tt.col {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if !errors.As(gr.Error(), &ce) || ce.Expected != tt.expected || ce.Actual != tt.actual {
+				t.Fatalf("cause check failed: %v", gr.Error())
+			}
+		})
+	}
+}
+
+func TestColumnsLenient(t *testing.T) {
+	gr := New(bytes.NewBufferString("a\tb\nc\nd\te\tf\ng\th\n"), WithColumns(2), WithLenient(0))
+	var ret [][]string
+	for gr.Next() {
+		ret = append(ret, gr.Strings())
+	}
+
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	expected := [][]string{{"a", "b"}, {"g", "h"}}
+	if !reflect.DeepEqual(expected, ret) {
+		t.Fatalf("returned value check failed expected: %v, actual: %v", expected, ret)
+	}
+	if n := len(gr.Errors()); n != 2 {
+		t.Fatalf("%d errors are recorded", n)
+	}
+}
+
+func TestColumnsWithRagged(t *testing.T) {
+	gr := New(bytes.NewBufferString("a\n"), WithColumns(2), WithRaggedRows())
+	if gr.Next() || gr.Error() == nil {
+		t.Fatalf("WithColumns and WithRaggedRows should be error")
+	}
+}
@@ -0,0 +1,145 @@
+package gtsv
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+)
+
+// ToCSV reads TSV from r and writes it to w as CSV.
+// Columns are unescaped, and quoted by encoding/csv if needed.
+// With `WithHeader()` , header is written as the first row.
+// If reading fails, rows before the error are written and the error is returned.
+func ToCSV(w io.Writer, r io.Reader, opts ...Option) error {
+	gr := New(r, opts...)
+	cw := csv.NewWriter(w)
+
+	wroteHeader := !gr.withHeader // nothing to write without header
+	for gr.Next() {
+		if !wroteHeader {
+			if err := cw.Write(gr.Header()); err != nil {
+				return err
+			}
+			wroteHeader = true
+		}
+		if err := cw.Write(gr.Strings()); err != nil {
+			return err
+		}
+	}
+	if err := gr.Error(); err != nil {
+		cw.Flush() // rows before the error
+		return err
+	}
+	if !wroteHeader && gr.Header() != nil {
+		if err := cw.Write(gr.Header()); err != nil { // no row but header
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ToJSONL reads TSV from r and writes each row to w as a line of JSON object.
+// Keys are column names of header with `WithHeader()` , or names of Schema with `WithSchema()` ,
+// otherwise column numbers starting from 1. Keys are in the order of columns.
+// With `WithSchema()` , values are typed by the types of columns, and null column is null.
+// time is written in RFC 3339 format, and NaN and Inf of float64 are written as strings like "NaN".
+// Otherwise, values are unescaped strings.
+// If reading fails, rows before the error are written and the error is returned.
+func ToJSONL(w io.Writer, r io.Reader, opts ...Option) error {
+	gr := New(r, opts...)
+	bw := bufio.NewWriter(w)
+
+	var line []byte
+	var buf bytes.Buffer // reused for each key and value
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false) // keep <, > and & as they are
+	appendJSON := func(v interface{}) error {
+		buf.Reset()
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		line = append(line, bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})...) // Encode appends newline
+		return nil
+	}
+	for gr.Next() {
+		line = append(line[:0], '{')
+		n := len(gr.fields)
+		if gr.schema != nil {
+			n = len(gr.schema.Columns)
+		}
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				line = append(line, ',')
+			}
+			if err := appendJSON(gr.columnKey(i)); err != nil {
+				return err
+			}
+			line = append(line, ':')
+			if err := appendJSON(gr.jsonValue()); err != nil {
+				return err
+			}
+		}
+		if err := gr.Error(); err != nil {
+			bw.Flush() // rows before the error, without this row
+			return err
+		}
+		line = append(line, '}', '\n')
+		if _, err := bw.Write(line); err != nil {
+			return err
+		}
+	}
+	if err := gr.Error(); err != nil {
+		bw.Flush() // rows before the error
+		return err
+	}
+	return bw.Flush()
+}
+
+// columnKey returns the name of i-th column, starting from 0, used as key of JSON.
+func (gr *Reader) columnKey(i int) string {
+	if i < len(gr.header) {
+		return gr.header[i]
+	}
+	if gr.schema != nil && gr.schema.Columns[i].Name != "" {
+		return gr.schema.Columns[i].Name
+	}
+	return strconv.Itoa(i + 1)
+}
+
+// jsonValue reads next column as the value of JSON.
+// It is typed by the schema if Reader has.
+func (gr *Reader) jsonValue() interface{} {
+	if gr.schema == nil {
+		return gr.String()
+	}
+
+	c := &gr.schema.Columns[gr.col]
+	if c.Nullable && gr.null() { // null of other columns is rejected by the schema, or text
+		return nil
+	}
+	switch c.Type {
+	case TypeInt:
+		return gr.Int64()
+	case TypeUint:
+		return gr.Uint64()
+	case TypeUint8:
+		return gr.Uint8()
+	case TypeFloat64:
+		f := gr.Float64()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return strconv.FormatFloat(f, 'g', -1, 64) // JSON has no NaN and Inf, so "NaN", "+Inf" or "-Inf"
+		}
+		return f
+	case TypeBool:
+		return gr.Bool()
+	case TypeTime:
+		return gr.Time(c.Layout)
+	}
+	return gr.String()
+}
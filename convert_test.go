@@ -0,0 +1,177 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestToCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		opts     []Option
+		csv      string
+		hasError bool
+	}{
+		{
+			name: "simple",
+			tsv:  "1\ta\n2\tb\n",
+			csv:  "1,a\n2,b\n",
+		},
+		{
+			name: "header",
+			tsv:  "id\tname\n1\ta\n",
+			opts: []Option{WithHeader()},
+			csv:  "id,name\n1,a\n",
+		},
+		{
+			name: "header only",
+			tsv:  "id\tname\n",
+			opts: []Option{WithHeader()},
+			csv:  "id,name\n",
+		},
+		{
+			name: "unescaped and quoted",
+			tsv:  "a,b\tc\\td\te\\nf\t\"g\"\n",
+			csv:  "\"a,b\",c\td,\"e\nf\",\"\"\"g\"\"\"\n",
+		},
+		{
+			name:     "invalid",
+			tsv:      "1\tx\n",
+			opts:     []Option{WithSchema(Schema{Columns: []Column{{Type: TypeInt}, {Type: TypeInt}}})},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := ToCSV(&buf, bytes.NewBufferString(tt.tsv), tt.opts...)
+			if (err != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", err)
+			}
+			if !tt.hasError && buf.String() != tt.csv {
+				t.Fatalf("returned value check failed expected: %q, actual: %q", tt.csv, buf.String())
+			}
+		})
+	}
+}
+
+func TestToJSONL(t *testing.T) {
+	schema := Schema{Columns: []Column{
+		{Name: "id", Type: TypeInt},
+		{Name: "name", Type: TypeString},
+		{Name: "score", Type: TypeFloat64, Nullable: true},
+		{Name: "active", Type: TypeBool},
+		{Name: "joined", Type: TypeTime, Layout: "2006-01-02"},
+	}}
+
+	tests := []struct {
+		name     string
+		tsv      string
+		opts     []Option
+		jsonl    string
+		hasError bool
+	}{
+		{
+			name:  "no header",
+			tsv:   "1\ta\\tb\n",
+			jsonl: `{"1":"1","2":"a\tb"}` + "\n",
+		},
+		{
+			name:  "header",
+			tsv:   "name\tid\nbob\t1\n",
+			opts:  []Option{WithHeader()},
+			jsonl: `{"name":"bob","id":"1"}` + "\n",
+		},
+		{
+			name:  "HTML characters",
+			tsv:   "<a>\tb&c\n",
+			jsonl: `{"1":"<a>","2":"b&c"}` + "\n",
+		},
+		{
+			name: "schema",
+			tsv:  "1\tbob\t0.5\ttrue\t2018-01-02\n2\tcarol\t\\N\tfalse\t2018-01-03\n",
+			opts: []Option{WithSchema(schema)},
+			jsonl: `{"id":1,"name":"bob","score":0.5,"active":true,"joined":"2018-01-02T00:00:00Z"}` + "\n" +
+				`{"id":2,"name":"carol","score":null,"active":false,"joined":"2018-01-03T00:00:00Z"}` + "\n",
+		},
+		{
+			name:  "empty string",
+			tsv:   "1\t\t\\N\ttrue\t2018-01-02\n",
+			opts:  []Option{WithSchema(schema)},
+			jsonl: `{"id":1,"name":"","score":null,"active":true,"joined":"2018-01-02T00:00:00Z"}` + "\n",
+		},
+		{
+			name: "NaN and Inf",
+			tsv:  "1\tbob\tNaN\ttrue\t2018-01-02\n2\tcarol\t-Inf\tfalse\t2018-01-03\n",
+			opts: []Option{WithSchema(schema)},
+			jsonl: `{"id":1,"name":"bob","score":"NaN","active":true,"joined":"2018-01-02T00:00:00Z"}` + "\n" +
+				`{"id":2,"name":"carol","score":"-Inf","active":false,"joined":"2018-01-03T00:00:00Z"}` + "\n",
+		},
+		{
+			name:     "invalid",
+			tsv:      "x\tbob\t0.5\ttrue\t2018-01-02\n",
+			opts:     []Option{WithSchema(schema)},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := ToJSONL(&buf, bytes.NewBufferString(tt.tsv), tt.opts...)
+			if (err != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", err)
+			}
+			if !tt.hasError && buf.String() != tt.jsonl {
+				t.Fatalf("returned value check failed expected: %q, actual: %q", tt.jsonl, buf.String())
+			}
+		})
+	}
+}
+
+func TestConvertWritesRowsBeforeError(t *testing.T) {
+	// more rows than buffers of writers, then an invalid row
+	var tsv, csv, jsonl strings.Builder
+	for i := 1; i <= 1000; i++ {
+		fmt.Fprintf(&tsv, "row%04d\n", i)
+		fmt.Fprintf(&csv, "row%04d\n", i)
+		fmt.Fprintf(&jsonl, "{\"1\":\"row%04d\"}\n", i)
+	}
+	tsv.WriteString("x\ty\n")
+
+	tests := []struct {
+		name     string
+		convert  func(w io.Writer, r io.Reader, opts ...Option) error
+		expected string
+	}{
+		{
+			name:     "csv",
+			convert:  ToCSV,
+			expected: csv.String(),
+		},
+		{
+			name:     "jsonl",
+			convert:  ToJSONL,
+			expected: jsonl.String(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := tt.convert(&buf, strings.NewReader(tsv.String()), WithColumns(1))
+			if !errors.Is(err, ErrExtraColumn) {
+				t.Fatalf("error check failed: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Fatalf("rows before the error check failed, expected: %d bytes, actual: %d bytes", len(tt.expected), buf.Len())
+			}
+		})
+	}
+}
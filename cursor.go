@@ -0,0 +1,86 @@
+package gtsv
+
+// Skip skips next n columns without parsing them.
+// If the row doesn't have n more columns, it fails with ErrMissingColumn
+// at the column next to the last one.
+func (gr *Reader) Skip(n int) {
+	if gr.err != nil || n <= 0 {
+		return
+	}
+	if gr.col+n > len(gr.fields) && !gr.ragged {
+		gr.col = len(gr.fields) + 1
+		gr.fail(ErrMissingColumn, nil, nil)
+		return
+	}
+	gr.col += n
+}
+
+// SkipRest skips all remaining columns of current row,
+// so `Next()` doesn't report unread column.
+func (gr *Reader) SkipRest() {
+	if gr.err != nil {
+		return
+	}
+	gr.col = len(gr.fields)
+}
+
+// Peek returns next column unescaped without reading it.
+// Next typed method reads the same column.
+// In ragged mode, missing column is its default.
+// If error had happened, or the row has no more column, it returns nil.
+func (gr *Reader) Peek() []byte {
+	if gr.err != nil {
+		return nil
+	}
+	if gr.col < len(gr.fields) {
+		return gr.unescape(gr.fields[gr.col])
+	}
+	if gr.ragged && gr.col < len(gr.defaults) {
+		return gr.unescape(gr.defaults[gr.col])
+	}
+	return nil
+}
+
+// Remaining returns the number of columns not read yet in current row.
+// In ragged mode, missing columns which have defaults are counted too,
+// so it is the number of columns `Peek()` returns non-nil for.
+// If error had happened, it returns 0.
+func (gr *Reader) Remaining() int {
+	if gr.err != nil {
+		return 0
+	}
+	n := len(gr.fields)
+	if gr.ragged && len(gr.defaults) > n {
+		n = len(gr.defaults)
+	}
+	if gr.col >= n {
+		return 0
+	}
+	return n - gr.col
+}
+
+// Column returns i-th column of current row unescaped.
+// i starts from 1, same as `gtsv.Error.Col()` .
+// Like methods reading by name, next typed method reads the column after it,
+// and `Next()` doesn't care unread columns of the row.
+// If error had happened, or the row doesn't have the column, it returns nil.
+// In ragged mode, missing column is its default.
+func (gr *Reader) Column(i int) []byte {
+	if gr.err != nil {
+		return nil
+	}
+	gr.byName = true
+
+	if gr.ragged && i > len(gr.fields) {
+		gr.col = i
+		b, _ := gr.missingColumn()
+		return gr.unescape(b)
+	}
+	if i < 1 || i > len(gr.fields) {
+		gr.col = i
+		gr.fail(ErrMissingColumn, nil, nil)
+		return nil
+	}
+	gr.col = i
+	return gr.unescape(gr.fields[i-1])
+}
@@ -0,0 +1,120 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestSkip(t *testing.T) {
+	gr := New(bytes.NewBufferString("1\t2\t3\t4\n5\t6\n"))
+	gr.Next()
+	gr.Skip(2)
+	if n := gr.Remaining(); n != 2 {
+		t.Fatalf("Remaining() returned %d", n)
+	}
+	if v := gr.Int(); v != 3 {
+		t.Fatalf("returned value check failed: %d", v)
+	}
+	gr.SkipRest()
+	if n := gr.Remaining(); n != 0 {
+		t.Fatalf("Remaining() returned %d", n)
+	}
+
+	gr.Next()
+	if err := gr.Error(); err != nil {
+		t.Fatalf("skipped columns should not be error: %s", err)
+	}
+	gr.Skip(3)
+	var e Error
+	if !errors.As(gr.Error(), &e) || !errors.Is(gr.Error(), ErrMissingColumn) || e.Row() != 2 || e.Col() != 3 {
+		t.Fatalf("error check failed: %v", gr.Error())
+	}
+}
+
+func TestPeek(t *testing.T) {
+	gr := New(bytes.NewBufferString("a\\tb\t2\n"))
+	gr.Next()
+	if v := string(gr.Peek()); v != "a\tb" {
+		t.Fatalf("Peek() returned %q", v)
+	}
+	if v := gr.String(); v != "a\tb" {
+		t.Fatalf("Peek() should not consume column: %q", v)
+	}
+	if v := gr.Int(); v != 2 {
+		t.Fatalf("returned value check failed: %d", v)
+	}
+	if v := gr.Peek(); v != nil {
+		t.Fatalf("Peek() at the end of row returned %q", v)
+	}
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+}
+
+func TestColumn(t *testing.T) {
+	tests := []struct {
+		name     string
+		col      int
+		result   string
+		next     int
+		hasError bool
+	}{
+		{name: "first", col: 1, result: "a", next: 1},
+		{name: "middle", col: 2, result: "b", next: 2},
+		{name: "zero", col: 0, hasError: true},
+		{name: "out of range", col: 4, hasError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString("a\tb\t3\n1\t2\t3\n"))
+			gr.Next()
+			v := gr.Column(tt.col)
+			if (gr.Error() != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if tt.hasError {
+				var e Error
+				if !errors.As(gr.Error(), &e) || !errors.Is(gr.Error(), ErrMissingColumn) || e.Col() != tt.col {
+					t.Fatalf("error check failed: %v", gr.Error())
+				}
+				return
+			}
+			if string(v) != tt.result {
+				t.Fatalf("returned value check failed expected: %q, actual: %q", tt.result, v)
+			}
+			if n := gr.Remaining(); n != 3-tt.next {
+				t.Fatalf("Remaining() returned %d", n)
+			}
+
+			gr.Next() // unread columns are allowed after Column()
+			if err := gr.Error(); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+		})
+	}
+}
+
+func TestPeekRagged(t *testing.T) {
+	gr := New(bytes.NewBufferString("1\n"), WithRaggedRows("5", "7"))
+	gr.Next()
+	if n := gr.Remaining(); n != 2 {
+		t.Fatalf("Remaining() returned %d", n)
+	}
+	if v := gr.Int(); v != 1 {
+		t.Fatalf("returned value check failed: %d", v)
+	}
+	if v := string(gr.Peek()); v != "7" {
+		t.Fatalf("Peek() returned %q", v)
+	}
+	if n := gr.Remaining(); n != 1 {
+		t.Fatalf("Remaining() returned %d", n)
+	}
+	if v := gr.Int(); v != 7 {
+		t.Fatalf("returned value check failed: %d", v)
+	}
+	if v := gr.Peek(); v != nil || gr.Remaining() != 0 {
+		t.Fatalf("Peek() at the end of row returned %q", v)
+	}
+}
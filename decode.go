@@ -0,0 +1,308 @@
+package gtsv
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Decoder reads TSV rows into structs.
+// Struct fields are mapped to columns with `tsv` struct tag.
+//
+//	type user struct {
+//	  Name string `tsv:"name"` // column named "name" in header
+//	  Age  int    `tsv:"2"`    // 2nd column
+//	  Memo string              // no tag, ignored
+//	}
+//
+// Column names are available only if Decoder is created with `WithHeader()` .
+// Tag with both, like `tsv:"age,2"` , is read by name with header, and by number without header.
+// Column numbers start from 1, same as `gtsv.Error.Col()` .
+// Fields without tag, or tagged with "-", are ignored.
+// Fields of types registered by `WithParser()` , or implementing encoding.TextUnmarshaler,
+// are decoded with them.
+// time.Time is parsed like `Time()` with the layout and location of options.
+type Decoder struct {
+	r      *Reader
+	fields map[reflect.Type][]decField
+}
+
+// decField is struct field to be decoded from the column.
+type decField struct {
+	index []int // field index for reflect.Value.FieldByIndex
+	col   int   // column index, starts from 0
+}
+
+// NewDecoder returns new Decoder which reads from r.
+func NewDecoder(r io.Reader, opts ...Option) *Decoder {
+	return &Decoder{r: New(r, opts...), fields: map[reflect.Type][]decField{}}
+}
+
+// Unmarshal decodes all rows of data into v.
+// v must be a pointer to slice of struct, or a pointer to slice of pointer to struct.
+func Unmarshal(data []byte, v interface{}, opts ...Option) error {
+	return NewDecoder(bytes.NewReader(data), opts...).DecodeAll(v)
+}
+
+// Reader returns underlying Reader.
+// It's useful to see `Header()` .
+func (d *Decoder) Reader() *Reader {
+	return d.r
+}
+
+// Decode reads next row and stores it into v.
+// v must be a pointer to struct.
+// It returns io.EOF if there is no more row.
+// If a column couldn't be parsed, returned error implements `gtsv.Error` .
+// If v has no tagged field or has a field which can't be decoded, it returns error without reading the row.
+func (d *Decoder) Decode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("gtsv: Decode needs non-nil pointer to struct, but got %T", v)
+	}
+
+	// header is read first, so that fields are checked before the row is consumed
+	gr := d.r
+	if !gr.prepareHeader() {
+		if err := gr.Error(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+
+	fields, err := d.structFields(rv.Elem().Type())
+	if err != nil {
+		return err
+	}
+
+	if !gr.Next() {
+		if err := gr.Error(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+
+	rv = rv.Elem()
+	for _, f := range fields {
+		gr.byName = true // columns may be read in any order
+		gr.col = f.col
+		d.decodeField(rv.FieldByIndex(f.index))
+		if gr.err != nil {
+			return gr.err
+		}
+	}
+	return nil
+}
+
+// DecodeAll reads all rows and appends them into v.
+// v must be a pointer to slice of struct, or a pointer to slice of pointer to struct.
+// In lenient mode, failed rows are skipped and available with `Reader().Errors()` .
+func (d *Decoder) DecodeAll(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("gtsv: DecodeAll needs non-nil pointer to slice, but got %T", v)
+	}
+
+	slice := rv.Elem()
+	typ := slice.Type().Elem()
+	isPtr := typ.Kind() == reflect.Ptr
+	if isPtr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return fmt.Errorf("gtsv: DecodeAll needs slice of struct, but got %T", v)
+	}
+
+	for {
+		elem := reflect.New(typ)
+		err := d.Decode(elem.Interface())
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if d.r.recoverable() {
+				continue // failed row is skipped and recorded in lenient mode
+			}
+			return err
+		}
+
+		if isPtr {
+			slice.Set(reflect.Append(slice, elem))
+		} else {
+			slice.Set(reflect.Append(slice, elem.Elem()))
+		}
+	}
+}
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	durationType        = reflect.TypeOf(time.Duration(0))
+	timeType            = reflect.TypeOf(time.Time{})
+)
+
+// decodeField reads current column by typed method into fv.
+func (d *Decoder) decodeField(fv reflect.Value) {
+	gr := d.r
+	if fn, ok := gr.parsers[fv.Type()]; ok {
+		gr.parse(fv, fn)
+		return
+	}
+	if fv.Type() == timeType {
+		fv.Set(reflect.ValueOf(gr.Time(""))) // not by UnmarshalText, to use the layout and location of Reader
+		return
+	}
+	if fv.Type() == durationType {
+		fv.SetInt(int64(gr.Duration()))
+		return
+	}
+	if u, ok := fv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		gr.Value(u)
+		return
+	}
+
+	switch fv.Kind() {
+	case reflect.Int:
+		fv.SetInt(int64(gr.Int()))
+	case reflect.Int8:
+		fv.SetInt(int64(gr.Int8()))
+	case reflect.Int16:
+		fv.SetInt(int64(gr.Int16()))
+	case reflect.Int32:
+		fv.SetInt(int64(gr.Int32()))
+	case reflect.Int64:
+		fv.SetInt(gr.Int64())
+	case reflect.Uint:
+		fv.SetUint(uint64(gr.Uint()))
+	case reflect.Uint8:
+		fv.SetUint(uint64(gr.Uint8()))
+	case reflect.Uint16:
+		fv.SetUint(uint64(gr.Uint16()))
+	case reflect.Uint32:
+		fv.SetUint(uint64(gr.Uint32()))
+	case reflect.Uint64:
+		fv.SetUint(gr.Uint64())
+	case reflect.Float32:
+		fv.SetFloat(float64(gr.Float32()))
+	case reflect.Float64:
+		fv.SetFloat(gr.Float64())
+	case reflect.Bool:
+		fv.SetBool(gr.Bool())
+	case reflect.String:
+		fv.SetString(gr.String())
+	case reflect.Slice: // []byte, checked by structFields
+		b := gr.Bytes()
+		if b != nil {
+			b = append([]byte{}, b...) // copy, because buffer will be overwritten
+		}
+		fv.SetBytes(b)
+	}
+}
+
+// structFields returns fields of typ to be decoded.
+// It must be called after the header is read.
+func (d *Decoder) structFields(typ reflect.Type) ([]decField, error) {
+	if fields, ok := d.fields[typ]; ok {
+		return fields, nil
+	}
+
+	var fields []decField
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		tag := sf.Tag.Get("tsv")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		if sf.PkgPath != "" {
+			return nil, fmt.Errorf("gtsv: field %s is tagged but unexported", sf.Name)
+		}
+		if !d.isCustom(sf.Type) && !isDecodable(sf.Type) {
+			return nil, fmt.Errorf("gtsv: field %s has unsupported type %s", sf.Name, sf.Type)
+		}
+
+		col, err := d.column(tag)
+		if err != nil {
+			return nil, fmt.Errorf("gtsv: field %s: %s", sf.Name, err)
+		}
+		fields = append(fields, decField{index: sf.Index, col: col})
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("gtsv: %s has no field tagged with tsv", typ)
+	}
+
+	d.fields[typ] = fields
+	return fields, nil
+}
+
+// column returns column index which tag points.
+// If tag has both name and number, name is used with header, otherwise number is used.
+func (d *Decoder) column(tag string) (int, error) {
+	name, n, err := parseTag(tag)
+	if err != nil {
+		return 0, err
+	}
+	if name == "" || (n > 0 && !d.r.withHeader) {
+		if n < 1 {
+			return 0, fmt.Errorf("column number %d is out of range", n)
+		}
+		return n - 1, nil
+	}
+
+	if !d.r.withHeader {
+		return 0, errors.New("column name needs header, use WithHeader()")
+	}
+	i, ok := d.r.headerIndex[name]
+	if !ok {
+		return 0, fmt.Errorf("column %q is not found in header", name)
+	}
+	return i, nil
+}
+
+// parseTag returns column name and column number which `tsv` struct tag points.
+// tag is a name, a number, or a name and a number separated by comma like "name,2".
+// If the part after the last comma is not a number, whole tag is a name.
+// If tag has no name, name is empty. If tag has no number, n is 0.
+func parseTag(tag string) (name string, n int, err error) {
+	if n, err := strconv.Atoi(tag); err == nil {
+		return "", n, nil
+	}
+	i := strings.LastIndexByte(tag, ',')
+	if i < 0 {
+		return tag, 0, nil
+	}
+	n, err = strconv.Atoi(tag[i+1:])
+	if err != nil {
+		return tag, 0, nil // name contains comma
+	}
+	if n < 1 {
+		return "", 0, fmt.Errorf("column number %d is out of range", n)
+	}
+	return tag[:i], n, nil
+}
+
+// isCustom returns typ is registered by `WithParser()` or implements encoding.TextUnmarshaler.
+func (d *Decoder) isCustom(typ reflect.Type) bool {
+	if _, ok := d.r.parsers[typ]; ok {
+		return true
+	}
+	return reflect.PtrTo(typ).Implements(textUnmarshalerType)
+}
+
+// isDecodable returns typ can be decoded by Decoder.
+func isDecodable(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Bool, reflect.String:
+		return true
+	case reflect.Slice:
+		return typ.Elem().Kind() == reflect.Uint8
+	}
+	return false
+}
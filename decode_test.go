@@ -0,0 +1,231 @@
+package gtsv
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type decodeUser struct {
+	Name  string  `tsv:"name"`
+	Age   int     `tsv:"age"`
+	Score float64 `tsv:"score"`
+	Male  bool    `tsv:"male"`
+	Memo  []byte  `tsv:"memo"`
+	Skip  string
+}
+
+type decodeRecord struct {
+	ID    uint16 `tsv:"1"`
+	Value int8   `tsv:"3"`
+}
+
+func TestDecodeAll(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		opts     []Option
+		v        interface{}
+		result   interface{}
+		hasError bool
+		errRow   int
+		errCol   int
+	}{
+		{
+			name: "by name",
+			tsv: "male\tname\tage\tmemo\tscore\n" +
+				"true\tjohn\t18\ta\\tb\t1.5\n" +
+				"false\temily\t16\t\t2\n",
+			opts: []Option{WithHeader()},
+			v:    &[]decodeUser{},
+			result: &[]decodeUser{
+				{Name: "john", Age: 18, Score: 1.5, Male: true, Memo: []byte("a\tb")},
+				{Name: "emily", Age: 16, Score: 2, Male: false, Memo: []byte{}},
+			},
+		},
+		{
+			name: "by number",
+			tsv: "1\tx\t-1\n" +
+				"2\ty\t2\n",
+			v:      &[]*decodeRecord{},
+			result: &[]*decodeRecord{{ID: 1, Value: -1}, {ID: 2, Value: 2}},
+		},
+		{
+			name: "invalid value",
+			tsv: "1\tx\t-1\n" +
+				"2\ty\t128\n",
+			v:        &[]decodeRecord{},
+			result:   &[]decodeRecord{{ID: 1, Value: -1}},
+			hasError: true,
+			errRow:   2,
+			errCol:   3,
+		},
+		{
+			name: "missing column",
+			tsv: "1\tx\t-1\n" +
+				"2\ty\n",
+			v:        &[]decodeRecord{},
+			result:   &[]decodeRecord{{ID: 1, Value: -1}},
+			hasError: true,
+			errRow:   2,
+			errCol:   3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Unmarshal([]byte(tt.tsv), tt.v, tt.opts...)
+			if (err != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.result, tt.v) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, tt.v)
+			}
+
+			if err == nil {
+				return
+			}
+			er, ok := err.(Error)
+			if !ok {
+				t.Fatalf("invalid error %s", err)
+			}
+			if er.Row() != tt.errRow || er.Col() != tt.errCol {
+				t.Fatalf("invalid error tracer row: %d, col: %d", er.Row(), er.Col())
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	d := NewDecoder(bytes.NewBufferString("name\tage\n"+"john\t18\n"), WithHeader())
+
+	var u decodeUser
+	if err := d.Decode(&u); err == nil {
+		t.Fatalf("missing header column should be error")
+	}
+
+	d = NewDecoder(bytes.NewBufferString("name\tage\tscore\tmale\tmemo\n"+"john\t18\t1\tt\tm\n"), WithHeader())
+	if err := d.Decode(&u); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if u.Name != "john" || u.Age != 18 {
+		t.Fatalf("returned value check failed: %v", u)
+	}
+	if err := d.Decode(&u); err != io.EOF {
+		t.Fatalf("error is not io.EOF but %v", err)
+	}
+
+	if err := d.Decode(u); err == nil {
+		t.Fatalf("non-pointer should be error")
+	}
+
+	var s struct {
+		Name string `tsv:"name"`
+	}
+	if err := NewDecoder(bytes.NewBufferString("john\n")).Decode(&s); err == nil {
+		t.Fatalf("column name without header should be error")
+	}
+}
+
+func TestDecodeInvalidStruct(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface{}
+	}{
+		{
+			name: "unsupported type",
+			v: &struct {
+				ID  int         `tsv:"1"`
+				Map map[int]int `tsv:"2"`
+			}{},
+		},
+		{
+			name: "unexported",
+			v: &struct {
+				ID   int    `tsv:"1"`
+				name string `tsv:"2"`
+			}{},
+		},
+		{
+			name: "no tagged field",
+			v: &struct {
+				ID   int
+				Name string
+			}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDecoder(bytes.NewBufferString("1\tjohn\n"))
+			if err := d.Decode(tt.v); err == nil {
+				t.Fatalf("invalid struct should be error")
+			}
+
+			// row is not consumed
+			var r struct {
+				ID   int    `tsv:"1"`
+				Name string `tsv:"2"`
+			}
+			if err := d.Decode(&r); err != nil || r.ID != 1 || r.Name != "john" {
+				t.Fatalf("row should be decoded after error: %v, %v", r, err)
+			}
+		})
+	}
+}
+
+func TestDecodeDuration(t *testing.T) {
+	type job struct {
+		Name    string        `tsv:"1"`
+		Timeout time.Duration `tsv:"2"`
+	}
+
+	var ret []job
+	if err := Unmarshal([]byte("a\t1h30m\nb\t250ms\n"), &ret); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	expected := []job{{Name: "a", Timeout: 90 * time.Minute}, {Name: "b", Timeout: 250 * time.Millisecond}}
+	if !reflect.DeepEqual(expected, ret) {
+		t.Fatalf("returned value check failed expected: %v, actual: %v", expected, ret)
+	}
+
+	var buf bytes.Buffer
+	if err := Marshal(&buf, expected); err != nil || buf.String() != "a\t1h30m0s\nb\t250ms\n" {
+		t.Fatalf("Marshal() check failed: %q, %v", buf.String(), err)
+	}
+
+	err := Unmarshal([]byte("a\t100\n"), &ret)
+	if er, ok := err.(Error); !ok || er.Type() != "duration" {
+		t.Fatalf("invalid error %v", err)
+	}
+}
+
+func TestDecodeTime(t *testing.T) {
+	type event struct {
+		At  time.Time     `tsv:"1"`
+		For time.Duration `tsv:"2"`
+	}
+
+	jst := time.FixedZone("JST", 9*60*60)
+	var ret []event
+	err := Unmarshal([]byte("2024-01-02\t1h\n"), &ret, WithTimeLayout("2006-01-02"), WithLocation(jst))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	// same as Scan()
+	var at time.Time
+	var d time.Duration
+	gr := New(bytes.NewBufferString("2024-01-02\t1h\n"), WithTimeLayout("2006-01-02"), WithLocation(jst))
+	gr.Next()
+	if err := gr.Scan(&at, &d); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if len(ret) != 1 || !ret[0].At.Equal(at) || ret[0].At.Location() != jst || ret[0].For != d {
+		t.Fatalf("returned value check failed expected: %v %v, actual: %v", at, d, ret)
+	}
+}
@@ -0,0 +1,33 @@
+package gtsv
+
+import (
+	"fmt"
+)
+
+// Dialect describes the format of text which Reader reads.
+// Escape sequences are the same in any Dialect.
+// Rows are split by Delimiter before unescaping, so column can't contain Delimiter itself.
+type Dialect struct {
+	Delimiter  byte // separates columns
+	Terminator byte // terminates a row
+}
+
+// DefaultDialect is TSV, columns are separated by tab and rows are terminated by newline.
+// "\r\n" is treated as newline too, unless `WithStrictLF()` .
+var DefaultDialect = Dialect{Delimiter: '\t', Terminator: '\n'}
+
+// dialect returns current Dialect of Reader.
+func (gr *Reader) dialect() Dialect {
+	return Dialect{Delimiter: gr.delimiter, Terminator: gr.terminator}
+}
+
+// validate returns error if d can't be read.
+func (d Dialect) validate() error {
+	if d.Delimiter == d.Terminator {
+		return fmt.Errorf("gtsv: delimiter and terminator must be different, but both are %q", d.Delimiter)
+	}
+	if d.Delimiter == '\\' || d.Terminator == '\\' {
+		return fmt.Errorf("gtsv: backslash can't be delimiter or terminator, it is escape character")
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package gtsv
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestDialect(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		dialect  Dialect
+		result   [][]string
+		hasError bool
+		errRow   int
+		errCol   int
+	}{
+		{
+			name:    "pipe",
+			tsv:     "1|a\\tb\n2|c\td\n",
+			dialect: Dialect{Delimiter: '|', Terminator: '\n'},
+			result:  [][]string{{"1", "a\tb"}, {"2", "c\td"}},
+		},
+		{
+			name:    "semicolon",
+			tsv:     "1;a\n2;b\n",
+			dialect: Dialect{Delimiter: ';', Terminator: '\n'},
+			result:  [][]string{{"1", "a"}, {"2", "b"}},
+		},
+		{
+			name:    "unit and record separator",
+			tsv:     "1\x1fa\n\x1e2\x1fb\x1e",
+			dialect: Dialect{Delimiter: '\x1f', Terminator: '\x1e'},
+			result:  [][]string{{"1", "a\n"}, {"2", "b"}},
+		},
+		{
+			name:     "error position",
+			tsv:      "1|a\nx|b\n",
+			dialect:  Dialect{Delimiter: '|', Terminator: '\n'},
+			result:   [][]string{{"1", "a"}, {"0", ""}},
+			hasError: true,
+			errRow:   2,
+			errCol:   1,
+		},
+		{
+			name:     "invalid dialect",
+			tsv:      "1\n",
+			dialect:  Dialect{Delimiter: '\n', Terminator: '\n'},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), WithDialect(tt.dialect))
+			var ret [][]string
+			for gr.Next() {
+				n := gr.Int()
+				ret = append(ret, []string{strconv.Itoa(n), gr.String()})
+			}
+
+			err := gr.Error()
+			if (err != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", err)
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %q, actual: %q", tt.result, ret)
+			}
+
+			if er, ok := err.(Error); ok && (er.Row() != tt.errRow || er.Col() != tt.errCol) {
+				t.Fatalf("invalid error tracer row: %d, col: %d", er.Row(), er.Col())
+			}
+		})
+	}
+}
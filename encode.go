@@ -0,0 +1,250 @@
+package gtsv
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Encoder writes structs as TSV rows.
+// Struct fields are mapped to columns with `tsv` struct tag, same as Decoder.
+//
+// If fields are tagged with column names, columns are written in the order of fields
+// and the header is written before the first row.
+// If fields are tagged with column numbers, columns are written in the order of numbers
+// and the header is not written.
+// If fields are tagged with both, like `tsv:"name,2"` , columns are written in the order of numbers
+// and the header is written too. Columns no field points are empty, also in the header.
+// All fields in a struct must be tagged in the same way.
+//
+// Fields implementing encoding.TextMarshaler are written with it.
+// If MarshalText fails, it is the error of Writer with the row and col, and writing stops like I/O error.
+// time.Time is written in time.RFC3339Nano, which is read by the default layout of Reader,
+// and it can be changed by `SetTimeLayout()` .
+// time.Duration is written like "1h30m0s", which is read by `Reader.Duration()` .
+type Encoder struct {
+	w             *Writer
+	layout        string       // layout of time.Time
+	typ           reflect.Type // type of first encoded value, all values must be the same type
+	fields        []encField
+	headerWritten bool
+}
+
+// encField is struct field to be encoded into the column.
+type encField struct {
+	index []int // field index for reflect.Value.FieldByIndex
+	name  string
+	col   int // column index, starts from 0
+}
+
+// NewEncoder returns new Encoder which writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: NewWriter(w)}
+}
+
+// Marshal writes v to w as TSV.
+// v must be a slice of struct, a slice of pointer to struct, a struct or a pointer to struct.
+func Marshal(w io.Writer, v interface{}) error {
+	e := NewEncoder(w)
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		for i := 0; i < rv.Len(); i++ {
+			if err := e.Encode(rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+	} else if err := e.Encode(v); err != nil {
+		return err
+	}
+	return e.Flush()
+}
+
+// SetTimeLayout makes Encoder write time.Time in layout.
+// Use the same layout as `WithTimeLayout()` of Reader to read them back.
+func (e *Encoder) SetTimeLayout(layout string) {
+	e.layout = layout
+}
+
+// timeLayout returns the layout of time.Time.
+func (e *Encoder) timeLayout() string {
+	if e.layout == "" {
+		return time.RFC3339Nano
+	}
+	return e.layout
+}
+
+// Writer returns underlying Writer.
+func (e *Encoder) Writer() *Writer {
+	return e.w
+}
+
+// Flush writes buffered data to underlying io.Writer.
+func (e *Encoder) Flush() error {
+	return e.w.Flush()
+}
+
+// Encode writes v as a row.
+// v must be a struct or a pointer to struct,
+// and all values passed to an Encoder must be the same type.
+func (e *Encoder) Encode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("gtsv: Encode needs struct or pointer to struct, but got %T", v)
+	}
+
+	if e.typ == nil {
+		fields, err := encFields(rv.Type())
+		if err != nil {
+			return err
+		}
+		e.typ = rv.Type()
+		e.fields = fields
+	} else if e.typ != rv.Type() {
+		return fmt.Errorf("gtsv: Encode needs %s, but got %s", e.typ, rv.Type())
+	}
+
+	if !e.headerWritten {
+		e.headerWritten = true
+		if len(e.fields) > 0 && e.fields[0].name != "" {
+			col := 0
+			for _, f := range e.fields {
+				for ; col < f.col; col++ {
+					e.w.WriteBytes(nil) // fill the column no field points
+				}
+				e.w.WriteString(f.name)
+				col++
+			}
+			e.w.EndRow()
+		}
+	}
+
+	col := 0
+	for _, f := range e.fields {
+		for ; col < f.col; col++ {
+			e.w.WriteBytes(nil) // fill the column no field points
+		}
+		e.encodeField(rv.FieldByIndex(f.index))
+		col++
+	}
+	e.w.EndRow()
+	return e.w.Error()
+}
+
+// encodeField writes fv by typed method.
+func (e *Encoder) encodeField(fv reflect.Value) {
+	gw := e.w
+	if fv.Type() == timeType {
+		gw.WriteString(fv.Interface().(time.Time).Format(e.timeLayout()))
+		return
+	}
+	if fv.Type() == durationType {
+		gw.WriteString(time.Duration(fv.Int()).String()) // read back by `Reader.Duration()`
+		return
+	}
+	if m, ok := textMarshaler(fv); ok {
+		b, err := m.MarshalText()
+		if err != nil {
+			if gw.err == nil {
+				gw.err = gw.newMarshalError(typeName(fv.Type()), err)
+			}
+			return
+		}
+		gw.WriteBytes(b)
+		return
+	}
+
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		gw.WriteInt64(fv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		gw.WriteUint64(fv.Uint())
+	case reflect.Float32:
+		gw.WriteFloat32(float32(fv.Float()))
+	case reflect.Float64:
+		gw.WriteFloat64(fv.Float())
+	case reflect.Bool:
+		gw.WriteBool(fv.Bool())
+	case reflect.String:
+		gw.WriteString(fv.String())
+	case reflect.Slice: // []byte, checked by encFields
+		gw.WriteBytes(fv.Bytes())
+	}
+}
+
+// encFields returns fields of typ to be encoded, sorted by column order.
+func encFields(typ reflect.Type) ([]encField, error) {
+	var fields []encField
+	var named, numbered int
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		tag := sf.Tag.Get("tsv")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		if sf.PkgPath != "" {
+			return nil, fmt.Errorf("gtsv: field %s is tagged but unexported", sf.Name)
+		}
+		if !isDecodable(sf.Type) && !isTextMarshaler(sf.Type) {
+			return nil, fmt.Errorf("gtsv: field %s has unsupported type %s", sf.Name, sf.Type)
+		}
+
+		name, n, err := parseTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("gtsv: field %s: %s", sf.Name, err)
+		}
+		if name != "" {
+			named++
+		}
+		col := len(fields) // order of fields
+		if name == "" || n > 0 {
+			if n < 1 {
+				return nil, fmt.Errorf("gtsv: field %s: column number %d is out of range", sf.Name, n)
+			}
+			numbered++
+			col = n - 1
+		}
+		fields = append(fields, encField{index: sf.Index, name: name, col: col})
+	}
+
+	if (named > 0 && named < len(fields)) || (numbered > 0 && numbered < len(fields)) {
+		return nil, fmt.Errorf("gtsv: %s mixes column names and column numbers", typ)
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].col < fields[j].col })
+	for i := 1; i < len(fields); i++ {
+		if fields[i-1].col == fields[i].col {
+			return nil, fmt.Errorf("gtsv: %s has duplicated column number %d", typ, fields[i].col+1)
+		}
+	}
+	return fields, nil
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// isTextMarshaler returns typ or pointer to typ implements encoding.TextMarshaler.
+func isTextMarshaler(typ reflect.Type) bool {
+	return typ.Implements(textMarshalerType) || reflect.PtrTo(typ).Implements(textMarshalerType)
+}
+
+// textMarshaler returns fv as encoding.TextMarshaler if it implements.
+// If only pointer implements and fv is not addressable, fv is copied.
+func textMarshaler(fv reflect.Value) (encoding.TextMarshaler, bool) {
+	if m, ok := fv.Interface().(encoding.TextMarshaler); ok {
+		return m, true
+	}
+	if !reflect.PtrTo(fv.Type()).Implements(textMarshalerType) {
+		return nil, false
+	}
+	if !fv.CanAddr() {
+		v := reflect.New(fv.Type()).Elem()
+		v.Set(fv)
+		fv = v
+	}
+	return fv.Addr().Interface().(encoding.TextMarshaler), true
+}
@@ -0,0 +1,209 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        interface{}
+		tsv      string
+		hasError bool
+	}{
+		{
+			name: "by name",
+			v: []decodeUser{
+				{Name: "john", Age: 18, Score: 1.5, Male: true, Memo: []byte("a\tb"), Skip: "x"},
+				{Name: "emily", Age: 16, Score: 2},
+			},
+			tsv: "name\tage\tscore\tmale\tmemo\n" +
+				"john\t18\t1.5\ttrue\ta\\tb\n" +
+				"emily\t16\t2\tfalse\t\n",
+		},
+		{
+			name: "by number",
+			v:    []*decodeRecord{{ID: 1, Value: -1}, {ID: 2, Value: 2}},
+			tsv: "1\t\t-1\n" +
+				"2\t\t2\n",
+		},
+		{
+			name: "single struct",
+			v:    decodeRecord{ID: 1, Value: -1},
+			tsv:  "1\t\t-1\n",
+		},
+		{
+			name: "by name and number",
+			v: []struct {
+				Age  int    `tsv:"age,3"`
+				Name string `tsv:"name,1"`
+			}{{Age: 18, Name: "john"}},
+			tsv: "name\t\tage\n" +
+				"john\t\t18\n",
+		},
+		{
+			name: "name and number mixed with name",
+			v: []struct {
+				A int `tsv:"a,2"`
+				B int `tsv:"b"`
+			}{{A: 1, B: 2}},
+			hasError: true,
+		},
+		{
+			name: "invalid column number",
+			v: []struct {
+				A int `tsv:"a,0"`
+			}{{A: 1}},
+			hasError: true,
+		},
+		{
+			name: "mixed tags",
+			v: []struct {
+				A int `tsv:"a"`
+				B int `tsv:"2"`
+			}{{A: 1, B: 2}},
+			hasError: true,
+		},
+		{
+			name:     "not struct",
+			v:        []int{1},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Marshal(&buf, tt.v)
+			if (err != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", err)
+			}
+			if err != nil {
+				return
+			}
+
+			if buf.String() != tt.tsv {
+				t.Fatalf("written value check failed expected: %q, actual: %q", tt.tsv, buf.String())
+			}
+		})
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	users := []decodeUser{
+		{Name: "john\n", Age: 18, Score: 1.5, Male: true, Memo: []byte("a\\b")},
+		{Name: "emily", Age: 16, Score: 2, Memo: []byte{}},
+	}
+
+	var buf bytes.Buffer
+	if err := Marshal(&buf, users); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	var ret []decodeUser
+	if err := Unmarshal(buf.Bytes(), &ret, WithHeader()); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if !reflect.DeepEqual(users, ret) {
+		t.Fatalf("returned value check failed expected: %v, actual: %v", users, ret)
+	}
+}
+
+func TestMarshalOrderedRoundTrip(t *testing.T) {
+	type user struct {
+		Age  int    `tsv:"age,2"`
+		Name string `tsv:"name,1"`
+	}
+	users := []user{{Age: 18, Name: "john"}, {Age: 16, Name: "emily"}}
+
+	var buf bytes.Buffer
+	if err := Marshal(&buf, users); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if expected := "name\tage\njohn\t18\nemily\t16\n"; buf.String() != expected {
+		t.Fatalf("written value check failed expected: %q, actual: %q", expected, buf.String())
+	}
+
+	for _, opts := range [][]Option{{WithHeader()}, nil} {
+		b := buf.Bytes()
+		if opts == nil {
+			b = b[bytes.IndexByte(b, '\n')+1:] // read by number without header
+		}
+		var ret []user
+		if err := Unmarshal(b, &ret, opts...); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if !reflect.DeepEqual(users, ret) {
+			t.Fatalf("returned value check failed expected: %v, actual: %v", users, ret)
+		}
+	}
+}
+
+func TestMarshalTextMarshaler(t *testing.T) {
+	type access struct {
+		At time.Time     `tsv:"at"`
+		IP net.IP        `tsv:"ip"`
+		In time.Duration `tsv:"in"`
+	}
+
+	jst := time.FixedZone("JST", 9*60*60)
+	logs := []access{
+		{At: time.Date(2018, 1, 2, 3, 4, 5, 600, jst), IP: net.ParseIP("192.0.2.1"), In: time.Second},
+	}
+
+	var buf bytes.Buffer
+	if err := Marshal(&buf, logs); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	expected := "at\tip\tin\n2018-01-02T03:04:05.0000006+09:00\t192.0.2.1\t1s\n"
+	if buf.String() != expected {
+		t.Fatalf("returned value check failed expected: %q, actual: %q", expected, buf.String())
+	}
+
+	var ret []access
+	if err := Unmarshal(buf.Bytes(), &ret, WithHeader()); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if len(ret) != 1 || !ret[0].At.Equal(logs[0].At) || !ret[0].IP.Equal(logs[0].IP) || ret[0].In != logs[0].In {
+		t.Fatalf("round trip check failed expected: %v, actual: %v", logs, ret)
+	}
+
+	buf.Reset()
+	e := NewEncoder(&buf)
+	e.SetTimeLayout("2006-01-02")
+	if err := e.Encode(logs[0]); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if err := e.Flush(); err != nil || !strings.HasPrefix(buf.String(), "at\tip\tin\n2018-01-02\t") {
+		t.Fatalf("SetTimeLayout() check failed: %q, %v", buf.String(), err)
+	}
+}
+
+type failMarshaler struct{}
+
+func (failMarshaler) MarshalText() ([]byte, error) {
+	return nil, errors.New("marshal failed")
+}
+
+func TestMarshalTextMarshalerError(t *testing.T) {
+	type row struct {
+		ID int           `tsv:"1"`
+		V  failMarshaler `tsv:"2"`
+	}
+
+	var buf bytes.Buffer
+	err := Marshal(&buf, []row{{ID: 1}})
+	var e Error
+	if !errors.As(err, &e) || e.Row() != 1 || e.Col() != 2 || e.Type() != "gtsv.failMarshaler" {
+		t.Fatalf("invalid error %v", err)
+	}
+	if expected := "Write failed at row #1, col #2 as gtsv.failMarshaler: marshal failed"; err.Error() != expected {
+		t.Fatalf("error message check failed expected: %q, actual: %q", expected, err.Error())
+	}
+}
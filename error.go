@@ -1,25 +1,89 @@
 package gtsv
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 )
 
+// Kinds of error.
+// `gtsv.Error.Kind()` returns one of them,
+// and they can be matched with `errors.Is()` too.
+var (
+	// ErrSyntax is returned when column couldn't be parsed as the type
+	ErrSyntax = errors.New("invalid syntax")
+	// ErrRange is returned when column is out of range of the type
+	ErrRange = errors.New("value out of range")
+	// ErrMissingColumn is returned when row doesn't have the column to be read
+	ErrMissingColumn = errors.New("missing column")
+	// ErrExtraColumn is returned by `Next()` when previous row still has unread column
+	ErrExtraColumn = errors.New("extra column")
+	// ErrConstraint is returned when column violates constraint of Schema passed to `WithSchema()`
+	ErrConstraint = errors.New("constraint violated")
+	// ErrUnterminatedRow is returned when the last row doesn't end with newline in `WithStrictEOF()` mode
+	ErrUnterminatedRow = errors.New("unterminated row")
+	// ErrUnterminatedQuote is returned when quoted column isn't closed until EOF in `WithQuote()` mode
+	ErrUnterminatedQuote = errors.New("unterminated quote")
+	// ErrIO is returned when underlying io.Reader or io.Writer failed
+	ErrIO = errors.New("i/o error")
+)
+
+var errUnknownColumn = errors.New("no such column in header")
+
+// ColumnCountError is the cause of error when row doesn't have expected number of columns.
+// It is available with `errors.As()` .
+type ColumnCountError struct {
+	Expected int
+	Actual   int
+}
+
+// Error returns error message
+func (e *ColumnCountError) Error() string {
+	return fmt.Sprintf("expected %d columns, but got %d", e.Expected, e.Actual)
+}
+
+// maxRawLen is the max length of column value stored in the error.
+// Column may be very long, so it is truncated.
+const maxRawLen = 64
+
 // Error is the error interface.
 // If `gt.Error()` returned non-nil,
 // usually it implements this interface.
 // So, Row() and Col() will return error position.
+// Name() returns column name if Reader has header.
+// Type() and Raw() tell what was read as what.
+// Kind() returns the kind of error like `gtsv.ErrSyntax` .
+// Position() returns exact location in the input, like line number and byte offset.
+//
+// The underlying cause, such as `*strconv.NumError` or the error
+// returned by io.Reader, is available with `errors.Is()` and `errors.As()` .
 type Error interface {
 	Row() int
 	Col() int
+	Name() string
+	Type() string
+	Raw() []byte
+	Kind() error
+	Position() Position
 }
 
 // gtsverror contains row, col, type
 type gtsverror struct {
-	row int
-	col int
+	row  int
+	col  int
+	name string
+	typ  string
+	raw  []byte
+	kind error
+	err  error
+	pos  Position
+	op   string // "Parse" or "Write", what failed
 }
 
-// Row returns the row number error occurred
+// Row returns the row number error occurred.
+// It is the physical line number where the row starts, same as `Position().Line` ,
+// so header, skipped lines and lines of quoted column spanning lines are counted.
+// To know the number of rows returned by `Next()` , see `Position().Record` .
 func (e *gtsverror) Row() int {
 	return e.row
 }
@@ -29,7 +93,82 @@ func (e *gtsverror) Col() int {
 	return e.col
 }
 
+// Name returns the column name error occurred.
+// It is empty if Reader doesn't have header.
+func (e *gtsverror) Name() string {
+	return e.name
+}
+
+// Type returns the type name which column was read as, like "int8".
+// It is empty if error is not about parsing a column.
+func (e *gtsverror) Type() string {
+	return e.typ
+}
+
+// Raw returns the column value which couldn't be parsed.
+// It is truncated to 64 bytes.
+func (e *gtsverror) Raw() []byte {
+	return e.raw
+}
+
+// Position returns the position in the input error occurred
+func (e *gtsverror) Position() Position {
+	return e.pos
+}
+
+// Kind returns the kind of error, one of the sentinel errors like ErrSyntax
+func (e *gtsverror) Kind() error {
+	return e.kind
+}
+
+// Is reports whether target is the kind of error,
+// so `errors.Is(err, gtsv.ErrRange)` works.
+func (e *gtsverror) Is(target error) bool {
+	return e.kind != nil && e.kind == target
+}
+
+// Unwrap returns the underlying cause
+func (e *gtsverror) Unwrap() error {
+	return e.err
+}
+
 // Error returns error message
 func (e *gtsverror) Error() string {
-	return fmt.Sprintf("Parse failed at row #%d, col #%d", e.row, e.col)
+	op := e.op
+	if op == "" {
+		op = "Parse"
+	}
+	if e.op == "Write" && e.row == 0 { // flushing Writer has no position
+		return op + " failed: " + e.err.Error()
+	}
+	msg := fmt.Sprintf("%s failed at row #%d, col #%d", op, e.row, e.col)
+	if e.name != "" {
+		msg += fmt.Sprintf(" (%s)", e.name)
+	}
+	if e.typ != "" {
+		msg += " as " + e.typ
+	}
+	if e.err != nil {
+		msg += ": " + e.err.Error()
+	} else if e.kind != nil {
+		msg += ": " + e.kind.Error()
+	}
+	return msg
+}
+
+// setCause stores raw and cause.
+// raw is copied because it refers the buffer of Reader which will be overwritten.
+func (e *gtsverror) setCause(raw []byte, cause error) {
+	if len(raw) > maxRawLen {
+		raw = raw[:maxRawLen]
+	}
+	if raw != nil {
+		e.raw = append([]byte{}, raw...)
+	}
+
+	if ne, ok := cause.(*strconv.NumError); ok {
+		// NumError.Num refers the buffer too
+		cause = &strconv.NumError{Func: ne.Func, Num: string(e.raw), Err: ne.Err}
+	}
+	e.err = cause
 }
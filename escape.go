@@ -0,0 +1,66 @@
+package gtsv
+
+// escapeByte returns the byte c is escaped to, like 'n' for '\n'.
+// If c doesn't need escaping, it returns false.
+// It is the reverse of unescapeByte, so escaped column is read back identically by `Reader.Bytes()` .
+func escapeByte(c byte) (byte, bool) {
+	switch c {
+	case '\b':
+		return 'b', true
+	case '\f':
+		return 'f', true
+	case '\r':
+		return 'r', true
+	case '\n':
+		return 'n', true
+	case '\t':
+		return 't', true
+	case 0:
+		return '0', true
+	case '\'', '\\':
+		return c, true
+	}
+	return 0, false
+}
+
+// appendEscaped appends src to dst with escaping, and returns extended buffer.
+func appendEscaped(dst, src []byte) []byte {
+	for len(src) > 0 {
+		// append bytes until the one which needs escaping at once
+		n := 0
+		for ; n < len(src); n++ {
+			if _, ok := escapeByte(src[n]); ok {
+				break
+			}
+		}
+		dst = append(dst, src[:n]...)
+		if n == len(src) {
+			break
+		}
+
+		c, _ := escapeByte(src[n])
+		dst = append(dst, '\\', c)
+		src = src[n+1:]
+	}
+	return dst
+}
+
+// unescapeByte returns the byte which `\c` represents.
+func unescapeByte(c byte) byte {
+	switch c {
+	case 'b':
+		return '\b'
+	case 'f':
+		return '\f'
+	case 'r':
+		return '\r'
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case '0':
+		return 0
+	default: // '\'', '\\' and unknown sequence
+		return c
+	}
+}
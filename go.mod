@@ -0,0 +1,3 @@
+module github.com/yagi5/gtsv
+
+go 1.13
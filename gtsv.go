@@ -2,10 +2,11 @@ package gtsv
 
 import (
 	"bytes"
-	"fmt"
+	"errors"
 	"io"
-	"math"
+	"reflect"
 	"strconv"
+	"time"
 	"unsafe"
 )
 
@@ -14,22 +15,88 @@ import (
 // It shouldn't be used by client so unexported.
 type Reader struct {
 	reader       io.Reader
-	readBuff     []byte // temporary buffer which stores line
-	colBuff      []byte // buffer which stores current column
-	reservedBuff []byte // basically won't used. if `buff` is not enough to store line, copy readBuff into this for backup.
+	readBuff     []byte   // temporary buffer which stores line
+	line         []byte   // current row as it was read
+	fields       [][]byte // columns of current row
+	fieldPos     []int    // start positions of columns in line
+	escBuff      []byte   // buffer which stores unescaped columns of current row
+	rowBuff      [][]byte // buffer which stores unescaped fields returned by `Fields()`
+	reservedBuff []byte   // basically won't used. if `buff` is not enough to store line, copy readBuff into this for backup.
 	readErr      error
 	col          int
 	row          int
 	err          error
-	needUnescape bool
+
+	pos      Position // position of current row
+	records  int      // number of rows returned by `Next()`
+	lines    int      // number of lines read
+	offset   int64    // number of bytes read as lines
+	lineNum  int      // line number of the line returned by readLine
+	lineOffs int64    // byte offset of the line returned by readLine
+	lastLine bool     // line returned by readLine has no record terminator
+
+	withHeader  bool
+	header      []string
+	headerIndex map[string]int
+	byName      bool // current row was read by column name, so unread columns are allowed
+
+	lenient   bool
+	maxErrors int
+	errs      []error // errors of failed rows in lenient mode
+	aborted   bool
+	lateErr   bool // current error was found after the row had been returned, so the row isn't dead letter
+
+	delimiter  byte
+	terminator byte
+	strictLF   bool
+	quote      bool
+	comment    []byte
+	skipEmpty  bool
+	nullValues [][]byte
+	timeLayout string
+	location   *time.Location
+	parsers    map[reflect.Type]ParseFunc
+	columns    int // expected number of columns, 0 means any
+	schema     *Schema
+	ragged     bool
+	defaults   [][]byte // columns used if missing in ragged mode
+	recordBuff []byte   // buffer which stores quoted row spanning lines
+	quoteBuff  []byte   // buffer which stores unquoted columns of current row
+	strictEOF  bool
+	lineEnding LineEnding
+
+	deadLetter       io.Writer
+	deadLetterReason bool
+	deadLetterBuff   []byte
 
 	buff [6 << 10]byte // large enough
 }
 
 // New returnds new TSV reader.
 // This holds passed io.Reader to read it from.
-func New(r io.Reader) *Reader {
-	return &Reader{reader: r, err: nil}
+// Reading behavior can be changed by passing options.
+func New(r io.Reader, opts ...Option) *Reader {
+	gr := &Reader{reader: r, err: nil, delimiter: DefaultDialect.Delimiter, terminator: DefaultDialect.Terminator, nullValues: defaultNullValues, timeLayout: time.RFC3339, location: time.UTC}
+	for _, opt := range opts {
+		opt(gr)
+	}
+	if err := gr.dialect().validate(); err != nil {
+		gr.err = err
+	}
+	if gr.location == nil {
+		gr.err = errors.New("gtsv: WithLocation needs non-nil location")
+	}
+	if gr.columns > 0 && gr.ragged {
+		gr.err = errors.New("gtsv: WithColumns can't be used with WithRaggedRows")
+	}
+	if gr.schema != nil {
+		if gr.ragged {
+			gr.err = errors.New("gtsv: WithSchema can't be used with WithRaggedRows")
+		} else if err := gr.schema.validate(); err != nil {
+			gr.err = err
+		}
+	}
+	return gr
 }
 
 // Error returns TSV reading error.
@@ -42,52 +109,88 @@ func (gr *Reader) Error() error {
 
 // hasNextColumn returns client called Next() even row still has unread column
 func (gr *Reader) hasNextColumn() bool {
-	return gr.colBuff != nil && gr.readBuff != nil
+	return !gr.byName && !gr.ragged && gr.col < len(gr.fields)
 }
 
 // Next returns true when next row exists.
 // It's expected to use with `for` .
 // If error had happened, `Next()` returns always false.
+// In lenient mode, `Next()` continues to next row even if current row has error.
 func (gr *Reader) Next() bool {
-	if gr.err != nil {
-		return false
+	if gr.err == nil && gr.hasNextColumn() {
+		gr.col++ // gtsverror.col will be unread column position number
+		gr.fail(ErrExtraColumn, gr.fields[gr.col-1], nil)
+		gr.lateErr = true
 	}
 
-	if gr.hasNextColumn() {
-		gr.col++ // gtsverror.col will be unread column position number
-		gr.err = gr.newError()
-		return false
+	for {
+		if gr.err != nil && !gr.recoverRow() {
+			return false
+		}
+
+		if gr.withHeader && gr.header == nil && !gr.readHeader() {
+			return false
+		}
+
+		gr.col = 0
+		gr.pos.Record = gr.records + 1 // counted only if the row is returned
+		gr.byName = false
+		gr.escBuff = gr.escBuff[:0]
+		line, ok := gr.readRecord()
+		if !ok {
+			gr.line = nil
+			gr.fields = nil
+			gr.fieldPos = nil
+			if gr.err == nil {
+				gr.pos.Record = gr.records // no more row
+			}
+			return false
+		}
+		gr.line = line
+		gr.splitLine(line)
+		if gr.checkColumns() && gr.checkSchema() {
+			gr.records++
+			return true
+		}
+		// invalid row is rejected, and skipped in lenient mode
 	}
+}
 
-	gr.col = 0
-	gr.row++
+// readLine returns next line without record terminator.
+// If there is no more line or error had happened, it returns false.
+func (gr *Reader) readLine() ([]byte, bool) {
 	for {
 		if len(gr.readBuff) <= 0 {
 			if gr.readErr != nil {
-				gr.err = gr.readErr
-				if gr.err != io.EOF {
-					gr.err = gr.newError()
-
+				if gr.readErr != io.EOF {
+					gr.pos.Line = gr.lines + 1
+					gr.pos.Offset = gr.offset
+					gr.row = gr.pos.Line
+					gr.fail(ErrIO, nil, gr.readErr) // keep original error reachable by errors.Is
 				} else if len(gr.reservedBuff) > 0 {
-					gr.err = gr.newError()
-
-				} else {
-					gr.err = nil
+					// last line doesn't end with record terminator
+					if gr.strictEOF {
+						gr.pos.Line = gr.lines + 1
+						gr.pos.Offset = gr.offset
+						gr.row = gr.pos.Line
+						gr.fail(ErrUnterminatedRow, gr.reservedBuff, io.ErrUnexpectedEOF)
+						return nil, false
+					}
+					read := gr.reservedBuff
+					gr.reservedBuff = gr.reservedBuff[:0] // make empty
+					gr.consume(read, false)
+					return read, true
 				}
-				return false
+				return nil, false
 			}
 			n, err := gr.reader.Read(gr.buff[:]) // first, read and get some bytes and store to buffer
 			gr.readBuff = gr.buff[:n]
-			if err == io.EOF {
+			if err != nil {
 				gr.readErr = err
-			} else if err != nil {
-				gr.readErr = gr.newError()
 			}
-			gr.needUnescape = (bytes.IndexByte(gr.readBuff, '\\') >= 0)
-
 		}
 
-		n := bytes.IndexByte(gr.readBuff, '\n') // read from buffer
+		n := bytes.IndexByte(gr.readBuff, gr.terminator) // read from buffer
 		if n >= 0 {
 			// next row found
 			read := gr.readBuff[:n]
@@ -99,331 +202,241 @@ func (gr *Reader) Next() bool {
 				read = gr.reservedBuff
 				gr.reservedBuff = gr.reservedBuff[:0] // make empty
 			}
-			gr.colBuff = read
-			return true
+			gr.consume(read, true)
+			return read, true
 		}
 		gr.reservedBuff = append(gr.reservedBuff, gr.readBuff...)
 		gr.readBuff = nil
 	}
 }
 
+// splitLine splits line by delimiter into fields.
+func (gr *Reader) splitLine(line []byte) {
+	line = gr.trimCR(line)
+	if gr.quote {
+		gr.splitQuoted(line)
+		return
+	}
+
+	fields := gr.fields[:0]
+	fieldPos := gr.fieldPos[:0]
+	pos := 0
+	for {
+		fieldPos = append(fieldPos, pos)
+		n := bytes.IndexByte(line, gr.delimiter) // look for delimiter
+		if n < 0 {
+			// delimiter is not found, the most right column
+			gr.fields = append(fields, line)
+			gr.fieldPos = fieldPos
+			return
+		}
+		fields = append(fields, line[:n])
+		line = line[n+1:]
+		pos += n + 1
+	}
+}
+
 // Int returns next column as int.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Int() int {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := strconv.Atoi(bytesToString(b))
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("int", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.Atoi(s)
-	if err == nil {
-		return n
-	}
-	gr.err = gr.newError()
-	return 0
+	return n
 }
 
 // Uint returns next column as uint.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Uint() uint {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := parseUint(b, 0)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("uint", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.Atoi(s)
-	if err == nil && 0 <= n {
-		return uint(n)
-	}
-
-	gr.err = gr.newError()
-	return 0
+	return uint(n)
 }
 
 // Int8 returns next column as int8.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Int8() int8 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := strconv.ParseInt(bytesToString(b), 10, 8)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("int8", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.Atoi(s)
-	if err == nil && math.MinInt8 <= n && n <= math.MaxInt8 {
-		return int8(n)
-	}
-	gr.err = gr.newError()
-	return 0
+	return int8(n)
 }
 
 // Uint8 returns next column as uint8.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Uint8() uint8 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := parseUint(b, 8)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("uint8", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.Atoi(s)
-	if err == nil && 0 <= n && n <= math.MaxUint8 {
-		return uint8(n)
-	}
-	gr.err = gr.newError()
-	return 0
+	return uint8(n)
 }
 
 // Int16 returns next column as int16.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Int16() int16 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := strconv.ParseInt(bytesToString(b), 10, 16)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("int16", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.Atoi(s)
-	if err == nil && math.MinInt16 <= n && n <= math.MaxInt16 {
-		return int16(n)
-	}
-	gr.err = gr.newError()
-	return 0
+	return int16(n)
 }
 
 // Uint16 returns next column as uint16.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Uint16() uint16 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := parseUint(b, 16)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("uint16", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.Atoi(s)
-	if err == nil && 0 <= n && n <= math.MaxUint16 {
-		return uint16(n)
-	}
-	gr.err = gr.newError()
-	return 0
+	return uint16(n)
 }
 
 // Int32 returns next column as int32.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Int32() int32 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := strconv.ParseInt(bytesToString(b), 10, 32)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("int32", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.Atoi(s)
-	if err == nil && math.MinInt32 <= n && n <= math.MaxInt32 {
-		return int32(n)
-	}
-	gr.err = gr.newError()
-	return 0
+	return int32(n)
 }
 
 // Uint32 returns next column as uint32.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Uint32() uint32 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := parseUint(b, 32)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("uint32", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.Atoi(s)
-	if err == nil && 0 <= n && n <= math.MaxUint32 {
-		return uint32(n)
-	}
-	gr.err = gr.newError()
-	return 0
+	return uint32(n)
 }
 
 // Int64 returns next column as int64.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Int64() int64 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := strconv.ParseInt(bytesToString(b), 10, 64)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("int64", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.ParseInt(s, 10, 64)
-	if err == nil && math.MinInt64 <= n && n <= math.MaxInt64 {
-		return int64(n)
-	}
-	gr.err = gr.newError()
-	return 0
+	return n
 }
 
 // Uint64 returns next column as uint64.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Uint64() uint64 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := strconv.ParseUint(bytesToString(b), 10, 64)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("uint64", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.ParseUint(s, 10, 64)
-
-	if err == nil && 0 <= n && n <= math.MaxUint64 {
-		return uint64(n)
-	}
-	gr.err = gr.newError()
-	return 0
+	return n
 }
 
 // Float32 returns next column as float32.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Float32() float32 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := strconv.ParseFloat(bytesToString(b), 32)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("float32", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.ParseFloat(s, 32)
-
-	if err == nil {
-		return float32(n)
-	}
-	gr.err = gr.newError()
-	return 0
+	return float32(n)
 }
 
 // Float64 returns next column as float64.
 // If error had happened, it always returns zero-value.
 func (gr *Reader) Float64() float64 {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return 0
 	}
-	b, err := gr.nextColumn()
+
+	n, err := strconv.ParseFloat(bytesToString(b), 64)
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("float64", b, err)
 		return 0
 	}
-
-	s := bytesToString(b)
-	n, err := strconv.ParseFloat(s, 64)
-
-	if err == nil {
-		return n
-	}
-	gr.err = gr.newError()
-	return 0
+	return n
 }
 
 // Bytes returns next column as []byte.
 // If error had happened, it always returns nil.
 // Escape sequences will be unescaped.
 func (gr *Reader) Bytes() []byte {
-	if gr.err != nil {
-		return nil
-	}
-	b, err := gr.nextColumn()
-	if err != nil {
-		gr.err = gr.newError()
+	b, ok := gr.column()
+	if !ok {
 		return nil
 	}
-
-	if !gr.needUnescape {
-		return b
-	}
-
-	// need to unescape
-	n := bytes.IndexByte(b, '\\')
-	if n < 0 {
-		// Actually there was no '\'. Basically won't reach here
-		return b
-	}
-
-	n++
-	// for example: https://play.golang.org/p/UXkXBXLgsP_O
-	d := b[:n]
-	b = b[n:]
-	for len(b) > 0 {
-		switch b[0] {
-		case 'b':
-			d[len(d)-1] = '\b'
-		case 'f':
-			d[len(d)-1] = '\f'
-		case 'r':
-			d[len(d)-1] = '\r'
-		case 'n':
-			d[len(d)-1] = '\n'
-		case 't':
-			d[len(d)-1] = '\t'
-		case '0':
-			d[len(d)-1] = 0
-		case '\'':
-			d[len(d)-1] = '\''
-		case '\\':
-			d[len(d)-1] = '\\'
-		default:
-			d[len(d)-1] = b[0]
-		}
-
-		b = b[1:]
-		n = bytes.IndexByte(b, '\\')
-		if n < 0 {
-			d = append(d, b...)
-			break
-		}
-		n++
-		d = append(d, b[:n]...)
-		b = b[n:]
-	}
-	return d
+	return gr.unescape(b)
 }
 
 // String returns next column as string.
@@ -437,44 +450,91 @@ func (gr *Reader) String() string {
 // can read 1, t, T, TRUE, true, True, 0, f, F, FALSE, false, False.
 // If any other value, it will be false.
 func (gr *Reader) Bool() bool {
-	if gr.err != nil {
+	b, ok := gr.column()
+	if !ok {
 		return false
 	}
-	b, err := gr.nextColumn()
+
+	v, err := strconv.ParseBool(bytesToString(b))
 	if err != nil {
-		gr.err = gr.newError()
+		gr.failParse("bool", b, err)
 		return false
 	}
+	return v
+}
 
-	s := bytesToString(b)
-	n, err := strconv.ParseBool(s)
-	if err == nil {
-		return n
+// column returns next column.
+// If error had happened, or the row has no more column, it returns false.
+// In ragged mode, missing column is its default, and it returns false without error if no default.
+func (gr *Reader) column() ([]byte, bool) {
+	if gr.err != nil {
+		return nil, false
+	}
+	gr.col++
+	if gr.col > len(gr.fields) {
+		if gr.ragged {
+			return gr.missingColumn()
+		}
+		gr.fail(ErrMissingColumn, nil, nil)
+		return nil, false
 	}
-	gr.err = gr.newError()
-	return false
+	return gr.fields[gr.col-1], true
 }
 
-func (gr *Reader) nextColumn() ([]byte, error) {
-	gr.col++
-	if gr.readBuff == nil {
-		return nil, fmt.Errorf("no more columns")
+// unescape returns b with escape sequences unescaped.
+// Unescaped column is stored into escBuff, so b itself is never modified.
+// In quote mode, backslash is not escape character and it returns b as it is.
+func (gr *Reader) unescape(b []byte) []byte {
+	if gr.quote {
+		return b
 	}
 
-	n := bytes.IndexByte(gr.colBuff, '\t') // look for tab
+	n := bytes.IndexByte(b, '\\')
 	if n < 0 {
-		// tab is not found, the most right column
-		read := gr.colBuff
-		gr.colBuff = nil
-		return read, nil
-	}
-	read := gr.colBuff[:n]
-	gr.colBuff = gr.colBuff[n+1:]
-	return read, nil
+		return b
+	}
+
+	start := len(gr.escBuff)
+	for n >= 0 && n+1 < len(b) {
+		gr.escBuff = append(gr.escBuff, b[:n]...)
+		gr.escBuff = append(gr.escBuff, unescapeByte(b[n+1]))
+		b = b[n+2:]
+		n = bytes.IndexByte(b, '\\')
+	}
+	gr.escBuff = append(gr.escBuff, b...) // rest of column, it may end with '\'
+	return gr.escBuff[start:len(gr.escBuff):len(gr.escBuff)]
 }
 
-func (gr *Reader) newError() *gtsverror {
-	return &gtsverror{row: gr.row, col: gr.col}
+// fail stores the error which happened at current column.
+// kind is one of the sentinel errors, raw is the column and cause is the underlying error.
+func (gr *Reader) fail(kind error, raw []byte, cause error) *gtsverror {
+	e := &gtsverror{row: gr.row, col: gr.col, kind: kind, pos: gr.Position()}
+	if 0 < gr.col && gr.col <= len(gr.header) {
+		e.name = gr.header[gr.col-1]
+	} else if gr.schema != nil && 0 < gr.col && gr.col <= len(gr.schema.Columns) {
+		e.name = gr.schema.Columns[gr.col-1].Name
+	}
+	e.setCause(raw, cause)
+	gr.err = e
+	return e
+}
+
+// failParse stores the error which happened while parsing current column as typ.
+func (gr *Reader) failParse(typ string, raw []byte, cause error) {
+	kind := ErrSyntax
+	if errors.Is(cause, strconv.ErrRange) {
+		kind = ErrRange
+	}
+	gr.fail(kind, raw, cause).typ = typ
+}
+
+// parseUint parses b as unsigned integer of bitSize.
+// Unlike strconv.ParseUint, it accepts one leading '+' , and '-' of zero like "-0", as strconv.Atoi does.
+func parseUint(b []byte, bitSize int) (uint64, error) {
+	if 1 < len(b) && (b[0] == '+' || (b[0] == '-' && len(bytes.Trim(b[1:], "0")) == 0)) {
+		b = b[1:]
+	}
+	return strconv.ParseUint(bytesToString(b), 10, bitSize)
 }
 
 func bytesToString(b []byte) string {
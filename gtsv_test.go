@@ -2,9 +2,13 @@ package gtsv
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestInt(t *testing.T) {
@@ -83,6 +87,22 @@ func TestUint(t *testing.T) {
 			col:    3,
 			result: [][]uint{[]uint{1, 2, 3}, []uint{4, 5, 6}},
 		},
+		{
+			name: "contains plus sign",
+			tsv: "+1\t+2\t+3\n" +
+				"4\t5\t+6\n",
+			row:    2,
+			col:    3,
+			result: [][]uint{[]uint{1, 2, 3}, []uint{4, 5, 6}},
+		},
+		{
+			name: "contains negative zero",
+			tsv: "-0\t2\t3\n" +
+				"4\t5\t-00\n",
+			row:    2,
+			col:    3,
+			result: [][]uint{[]uint{0, 2, 3}, []uint{4, 5, 0}},
+		},
 		{
 			name: "contains negative number",
 			tsv: "1\t2\t3\n" +
@@ -217,6 +237,22 @@ func TestUint8(t *testing.T) {
 			col:    3,
 			result: [][]uint8{[]uint8{1, 2, 3}, []uint8{4, 5, 6}},
 		},
+		{
+			name: "contains plus sign",
+			tsv: "+1\t+2\t+3\n" +
+				"4\t5\t+6\n",
+			row:    2,
+			col:    3,
+			result: [][]uint8{[]uint8{1, 2, 3}, []uint8{4, 5, 6}},
+		},
+		{
+			name: "contains negative zero",
+			tsv: "-0\t2\t3\n" +
+				"4\t5\t-00\n",
+			row:    2,
+			col:    3,
+			result: [][]uint8{[]uint8{0, 2, 3}, []uint8{4, 5, 0}},
+		},
 		{
 			name: "contains negative number",
 			tsv: "1\t2\t3\n" +
@@ -1192,3 +1228,283 @@ func TestReader(t *testing.T) {
 		t.Fatalf("error was io.EOF but %s", err)
 	}
 }
+
+type failReader struct {
+	r   io.Reader
+	err error
+}
+
+func (f *failReader) Read(p []byte) (int, error) {
+	n, err := f.r.Read(p)
+	if err == io.EOF {
+		return n, f.err
+	}
+	return n, err
+}
+
+func TestErrorCause(t *testing.T) {
+	ioErr := errors.New("disk failure")
+	tests := []struct {
+		name   string
+		reader io.Reader
+		opts   []Option
+		read   func(gr *Reader)
+		cause  error
+		typ    string
+		raw    string
+		errRow int
+		errCol int
+	}{
+		{
+			name:   "syntax",
+			reader: bytes.NewBufferString("1\tabc\n"),
+			read:   func(gr *Reader) { gr.Int(); gr.Int() },
+			cause:  strconv.ErrSyntax,
+			typ:    "int",
+			raw:    "abc",
+			errRow: 1,
+			errCol: 2,
+		},
+		{
+			name:   "range",
+			reader: bytes.NewBufferString("300\n"),
+			read:   func(gr *Reader) { gr.Uint8() },
+			cause:  strconv.ErrRange,
+			typ:    "uint8",
+			raw:    "300",
+			errRow: 1,
+			errCol: 1,
+		},
+		{
+			name:   "truncated raw",
+			reader: bytes.NewBufferString(strings.Repeat("a", 100) + "\n"),
+			read:   func(gr *Reader) { gr.Float64() },
+			cause:  strconv.ErrSyntax,
+			typ:    "float64",
+			raw:    strings.Repeat("a", 64),
+			errRow: 1,
+			errCol: 1,
+		},
+		{
+			name:   "io error",
+			reader: &failReader{r: bytes.NewBufferString("1\n2\n"), err: ioErr},
+			read:   func(gr *Reader) { gr.Int() },
+			cause:  ioErr,
+			errRow: 3,
+			errCol: 0,
+		},
+		{
+			name:   "unterminated row",
+			reader: bytes.NewBufferString("1\n2"),
+			opts:   []Option{WithStrictEOF()},
+			read:   func(gr *Reader) { gr.Int() },
+			cause:  io.ErrUnexpectedEOF,
+			raw:    "2",
+			errRow: 2,
+			errCol: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(tt.reader, tt.opts...)
+			for gr.Next() {
+				tt.read(gr)
+			}
+
+			err := gr.Error()
+			if !errors.Is(err, tt.cause) {
+				t.Fatalf("cause check failed expected: %v, actual: %v", tt.cause, err)
+			}
+
+			var er Error
+			if !errors.As(err, &er) {
+				t.Fatalf("invalid error %s", err)
+			}
+			if er.Row() != tt.errRow || er.Col() != tt.errCol {
+				t.Fatalf("invalid error tracer row: %d, col: %d", er.Row(), er.Col())
+			}
+			if er.Type() != tt.typ || string(er.Raw()) != tt.raw {
+				t.Fatalf("invalid error type: %s, raw: %q", er.Type(), er.Raw())
+			}
+		})
+	}
+}
+
+func TestErrorKeepsRaw(t *testing.T) {
+	gr := New(bytes.NewBufferString("1\tx\n"))
+	for gr.Next() {
+		gr.Int()
+		gr.Bool()
+	}
+
+	// buffer of Reader is reused, so error must not refer it
+	msg := gr.Error().Error()
+	gr.buff[2] = 'y'
+	if gr.Error().Error() != msg {
+		t.Fatalf("error message was changed: %s", gr.Error())
+	}
+}
+
+func TestErrorKeepsRawOfTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func(gr *Reader)
+	}{
+		{
+			name:  "duration",
+			parse: func(gr *Reader) { gr.Duration() },
+		},
+		{
+			name:  "time",
+			parse: func(gr *Reader) { gr.Time(time.RFC3339) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString("1\t9x\n2\t1h\n"), WithLenient(0))
+			for gr.Next() {
+				gr.Int()
+				tt.parse(gr)
+			}
+
+			// buffer of Reader is reused, so error must not refer it
+			msg := gr.Errors()[0].Error()
+			if !strings.Contains(msg, "9x") {
+				t.Fatalf("error message doesn't contain raw value: %s", msg)
+			}
+			gr.buff[2] = 'y'
+			gr.buff[3] = 'y'
+			if gr.Errors()[0].Error() != msg {
+				t.Fatalf("error message was changed: %s", gr.Errors()[0])
+			}
+		})
+	}
+}
+
+func TestErrorKind(t *testing.T) {
+	tests := []struct {
+		name   string
+		reader io.Reader
+		opts   []Option
+		cols   int
+		kind   error
+	}{
+		{
+			name:   "syntax",
+			reader: bytes.NewBufferString("1\ta\n"),
+			cols:   2,
+			kind:   ErrSyntax,
+		},
+		{
+			name:   "range",
+			reader: bytes.NewBufferString("1\t9223372036854775808\n"),
+			cols:   2,
+			kind:   ErrRange,
+		},
+		{
+			name:   "missing column",
+			reader: bytes.NewBufferString("1\t2\n"),
+			cols:   3,
+			kind:   ErrMissingColumn,
+		},
+		{
+			name:   "extra column",
+			reader: bytes.NewBufferString("1\t2\n"),
+			cols:   1,
+			kind:   ErrExtraColumn,
+		},
+		{
+			name:   "unterminated row",
+			reader: bytes.NewBufferString("1\t2\n3\t4"),
+			opts:   []Option{WithStrictEOF()},
+			cols:   2,
+			kind:   ErrUnterminatedRow,
+		},
+		{
+			name:   "io error",
+			reader: &failReader{r: bytes.NewBufferString("1\t2\n"), err: errors.New("disk failure")},
+			cols:   2,
+			kind:   ErrIO,
+		},
+	}
+
+	kinds := []error{ErrSyntax, ErrRange, ErrMissingColumn, ErrExtraColumn, ErrUnterminatedRow, ErrIO}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(tt.reader, tt.opts...)
+			for gr.Next() {
+				for i := 0; i < tt.cols; i++ {
+					gr.Int()
+				}
+			}
+
+			err := gr.Error()
+			for _, kind := range kinds {
+				if errors.Is(err, kind) != (kind == tt.kind) {
+					t.Fatalf("kind check failed expected: %v, actual: %v", tt.kind, err)
+				}
+			}
+			if er, ok := err.(Error); !ok || er.Kind() != tt.kind {
+				t.Fatalf("invalid error %v", err)
+			}
+		})
+	}
+}
+
+func TestLastRowWithoutNewline(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		opts     []Option
+		result   [][]int
+		hasError bool
+	}{
+		{
+			name:   "last row without newline",
+			tsv:    "1\t2\n3\t4",
+			result: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:   "single row without newline",
+			tsv:    "1\t2",
+			result: [][]int{{1, 2}},
+		},
+		{
+			name:   "CRLF without last newline",
+			tsv:    "1\t2\r\n3\t4\r",
+			result: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "strict",
+			tsv:      "1\t2\n3\t4",
+			opts:     []Option{WithStrictEOF()},
+			result:   [][]int{{1, 2}},
+			hasError: true,
+		},
+		{
+			name:   "strict with last newline",
+			tsv:    "1\t2\n3\t4\n",
+			opts:   []Option{WithStrictEOF()},
+			result: [][]int{{1, 2}, {3, 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			var ret [][]int
+			for gr.Next() {
+				ret = append(ret, []int{gr.Int(), gr.Int()})
+			}
+
+			if (gr.Error() != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+		})
+	}
+}
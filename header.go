@@ -0,0 +1,193 @@
+package gtsv
+
+// Header returns column names read from the first row.
+// It returns nil if Reader is not created with `WithHeader()` ,
+// or the first row is not read yet.
+func (gr *Reader) Header() []string {
+	return gr.header
+}
+
+// readHeader reads the first row as column names.
+func (gr *Reader) readHeader() bool {
+	line, ok := gr.readRecord()
+	if !ok {
+		return false
+	}
+
+	gr.splitLine(line)
+	gr.header = make([]string, len(gr.fields))
+	gr.headerIndex = make(map[string]int, len(gr.fields))
+	for i, f := range gr.fields {
+		name := string(gr.unescape(f)) // copy, because buffer will be overwritten
+		gr.header[i] = name
+		if _, ok := gr.headerIndex[name]; !ok {
+			gr.headerIndex[name] = i
+		}
+	}
+	if gr.schema != nil {
+		return gr.checkHeader()
+	}
+	return true
+}
+
+// prepareHeader reads header before the first row, if it's not read yet.
+// It returns false if header couldn't be read.
+func (gr *Reader) prepareHeader() bool {
+	if !gr.withHeader || gr.header != nil || gr.err != nil {
+		return true
+	}
+	ok := gr.readHeader()
+	gr.fields = nil // header is not a row, so it's not unread columns
+	gr.fieldPos = nil
+	return ok
+}
+
+// seek moves column cursor to the column named name,
+// then next typed method reads the column.
+// Once seek is called, `Next()` doesn't care unread columns of the row.
+func (gr *Reader) seek(name string) bool {
+	if gr.err != nil {
+		return false
+	}
+	gr.byName = true
+
+	i, ok := gr.headerIndex[name]
+	if !ok {
+		gr.col = 0
+		gr.fail(ErrMissingColumn, nil, errUnknownColumn).name = name
+		return false
+	}
+	gr.col = i
+	return true
+}
+
+// IntByName returns the column named name as int.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) IntByName(name string) int {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Int()
+}
+
+// UintByName returns the column named name as uint.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) UintByName(name string) uint {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Uint()
+}
+
+// Int8ByName returns the column named name as int8.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Int8ByName(name string) int8 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Int8()
+}
+
+// Uint8ByName returns the column named name as uint8.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Uint8ByName(name string) uint8 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Uint8()
+}
+
+// Int16ByName returns the column named name as int16.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Int16ByName(name string) int16 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Int16()
+}
+
+// Uint16ByName returns the column named name as uint16.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Uint16ByName(name string) uint16 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Uint16()
+}
+
+// Int32ByName returns the column named name as int32.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Int32ByName(name string) int32 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Int32()
+}
+
+// Uint32ByName returns the column named name as uint32.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Uint32ByName(name string) uint32 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Uint32()
+}
+
+// Int64ByName returns the column named name as int64.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Int64ByName(name string) int64 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Int64()
+}
+
+// Uint64ByName returns the column named name as uint64.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Uint64ByName(name string) uint64 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Uint64()
+}
+
+// Float32ByName returns the column named name as float32.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Float32ByName(name string) float32 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Float32()
+}
+
+// Float64ByName returns the column named name as float64.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Float64ByName(name string) float64 {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Float64()
+}
+
+// BytesByName returns the column named name as []byte.
+// If error had happened, it always returns nil.
+func (gr *Reader) BytesByName(name string) []byte {
+	if !gr.seek(name) {
+		return nil
+	}
+	return gr.Bytes()
+}
+
+// StringByName returns the column named name as string.
+func (gr *Reader) StringByName(name string) string {
+	return string(gr.BytesByName(name))
+}
+
+// BoolByName returns the column named name as bool.
+// If error had happened, it always returns false.
+func (gr *Reader) BoolByName(name string) bool {
+	if !gr.seek(name) {
+		return false
+	}
+	return gr.Bool()
+}
@@ -0,0 +1,122 @@
+package gtsv
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		header   []string
+		result   []string
+		hasError bool
+		errRow   int
+		errCol   int
+		errName  string
+	}{
+		{
+			name: "read by name",
+			tsv: "name\tage\tmale\n" +
+				"john\t18\ttrue\n" +
+				"emily\t16\tfalse\n",
+			header: []string{"name", "age", "male"},
+			result: []string{"john 18 true", "emily 16 false"},
+		},
+		{
+			name: "invalid value",
+			tsv: "name\tage\tmale\n" +
+				"john\t18\ttrue\n" +
+				"emily\tsixteen\tfalse\n",
+			header:   []string{"name", "age", "male"},
+			result:   []string{"john 18 true", " 0 false"}, // fail fast, name is not read
+			hasError: true,
+			errRow:   3, // header line is counted
+			errCol:   2,
+			errName:  "age",
+		},
+		{
+			name: "missing column",
+			tsv: "name\tmale\n" +
+				"john\ttrue\n",
+			header:   []string{"name", "male"},
+			result:   []string{" 0 true"},
+			hasError: true,
+			errRow:   2,
+			errCol:   0,
+			errName:  "age",
+		},
+		{
+			name:   "header only",
+			tsv:    "name\tage\tmale\n",
+			header: []string{"name", "age", "male"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), WithHeader())
+			var ret []string
+
+			for gr.Next() {
+				// read in different order from header
+				male := gr.BoolByName("male")
+				age := gr.IntByName("age")
+				name := gr.StringByName("name")
+				ret = append(ret, fmt.Sprintf("%s %d %t", name, age, male))
+			}
+
+			if !reflect.DeepEqual(tt.header, gr.Header()) {
+				t.Fatalf("header check failed expected: %v, actual: %v", tt.header, gr.Header())
+			}
+
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+
+			err := gr.Error()
+			if (err != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", err)
+			}
+			if err == nil {
+				return
+			}
+
+			er, ok := err.(Error)
+			if !ok {
+				t.Fatalf("invalid error %s", err)
+			}
+			if er.Row() != tt.errRow || er.Col() != tt.errCol || er.Name() != tt.errName {
+				t.Fatalf("invalid error tracer row: %d, col: %d, name: %s", er.Row(), er.Col(), er.Name())
+			}
+		})
+	}
+}
+
+func TestHeaderWithPositional(t *testing.T) {
+	tsv := "id\tscore\n" +
+		"1\t2.5\n" +
+		"2\tx\n"
+
+	gr := New(bytes.NewBufferString(tsv), WithHeader())
+	for gr.Next() {
+		gr.Int()
+		gr.Float64()
+	}
+
+	er, ok := gr.Error().(Error)
+	if !ok {
+		t.Fatalf("invalid error %s", gr.Error())
+	}
+	if er.Row() != 3 || er.Col() != 2 || er.Name() != "score" {
+		t.Fatalf("invalid error tracer row: %d, col: %d, name: %s", er.Row(), er.Col(), er.Name())
+	}
+
+	errmsg := `Parse failed at row #3, col #2 (score) as float64: strconv.ParseFloat: parsing "x": invalid syntax`
+	if gr.Error().Error() != errmsg {
+		t.Fatalf("invalid error message %s", gr.Error())
+	}
+}
@@ -0,0 +1,91 @@
+package gtsv
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Errors returns errors of failed rows recorded in lenient mode.
+// Each error implements `gtsv.Error` .
+// It includes extra column errors found by next `Next()` , whose rows have already been returned.
+func (gr *Reader) Errors() []error {
+	return gr.errs
+}
+
+// recoverable returns current error is the error of a row,
+// and reading can be continued to next row.
+func (gr *Reader) recoverable() bool {
+	if !gr.lenient || gr.aborted || gr.err == nil {
+		return false
+	}
+	return errors.Is(gr.err, ErrSyntax) || errors.Is(gr.err, ErrRange) ||
+		errors.Is(gr.err, ErrMissingColumn) || errors.Is(gr.err, ErrExtraColumn) ||
+		errors.Is(gr.err, ErrConstraint)
+}
+
+// recoverRow records the error of current row and clears it to continue to next row.
+// It returns false if reading can't be continued.
+func (gr *Reader) recoverRow() bool {
+	if !gr.recoverable() {
+		return false
+	}
+
+	gr.errs = append(gr.errs, gr.err)
+	if !gr.writeDeadLetter() {
+		return false
+	}
+	if gr.maxErrors > 0 && len(gr.errs) >= gr.maxErrors {
+		gr.aborted = true // keep gr.err
+		return false
+	}
+	gr.err = nil
+	gr.lateErr = false
+	return true
+}
+
+// writeDeadLetter writes current row to dead-letter writer.
+// The row is written with the delimiter and the record terminator of the dialect, so it can be read again.
+// The delimiter and the terminator in the reason are replaced, so the reason is always one column.
+// The row whose error was found after it had been returned, like unread extra column, isn't written.
+// If it failed, the error replaces current error and it returns false.
+func (gr *Reader) writeDeadLetter() bool {
+	if gr.deadLetter == nil || gr.line == nil || gr.lateErr {
+		return true
+	}
+
+	b := gr.deadLetterBuff[:0]
+	if gr.deadLetterReason {
+		start := len(b)
+		b = strconv.AppendInt(b, int64(gr.row), 10)
+		b = append(b, ": "...)
+		b = appendEscaped(b, []byte(gr.err.Error()))
+		gr.replaceDialectBytes(b[start:])
+		b = append(b, gr.delimiter)
+	}
+	b = append(b, gr.line...)
+	b = append(b, gr.terminator)
+	gr.deadLetterBuff = b
+
+	if _, err := gr.deadLetter.Write(b); err != nil {
+		gr.fail(ErrIO, nil, err)
+		return false
+	}
+	return true
+}
+
+// replaceDialectBytes replaces the delimiter and the record terminator in b,
+// which escaping can't represent, so b is read back as a column.
+// They are replaced with the first of ' ', '_' and '.' which is neither of them.
+func (gr *Reader) replaceDialectBytes(b []byte) {
+	var r byte
+	for _, r = range []byte(" _.") {
+		if r != gr.delimiter && r != gr.terminator {
+			break
+		}
+	}
+	for i, c := range b {
+		if c == gr.delimiter || c == gr.terminator {
+			b[i] = r
+		}
+	}
+}
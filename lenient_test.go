@@ -0,0 +1,268 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestLenient(t *testing.T) {
+	tests := []struct {
+		name      string
+		tsv       string
+		maxErrors int
+		col       int
+		result    [][]int
+		errRows   []int
+		errCols   []int
+		hasError  bool
+	}{
+		{
+			name: "collect all errors",
+			tsv: "1\t2\n" +
+				"a\t3\n" +
+				"4\t5\n" +
+				"6\tb\n" +
+				"7\n" +
+				"8\t9\t10\n" +
+				"11\t12\n",
+			col:     2,
+			result:  [][]int{{1, 2}, {4, 5}, {8, 9}, {11, 12}}, // extra column is found by next Next()
+			errRows: []int{2, 4, 5, 6},
+			errCols: []int{1, 2, 2, 3},
+		},
+		{
+			name: "abort by max errors",
+			tsv: "1\t2\n" +
+				"a\t3\n" +
+				"4\t5\n" +
+				"6\tb\n" +
+				"7\t8\n",
+			maxErrors: 2,
+			col:       2,
+			result:    [][]int{{1, 2}, {4, 5}},
+			errRows:   []int{2, 4},
+			errCols:   []int{1, 2},
+			hasError:  true,
+		},
+		{
+			name: "unterminated row",
+			tsv: "a\t2\n" +
+				"3\t4",
+			col:      2,
+			result:   nil,
+			errRows:  []int{1},
+			errCols:  []int{1},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), WithLenient(tt.maxErrors), WithStrictEOF())
+			var ret [][]int
+
+			for gr.Next() {
+				var line []int
+				for i := 0; i < tt.col; i++ {
+					line = append(line, gr.Int())
+				}
+				if gr.Error() != nil {
+					continue // failed row
+				}
+				ret = append(ret, line)
+			}
+
+			if (gr.Error() != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+
+			var rows, cols []int
+			for _, err := range gr.Errors() {
+				er := err.(Error)
+				rows = append(rows, er.Row())
+				cols = append(cols, er.Col())
+			}
+			if !reflect.DeepEqual(tt.errRows, rows) || !reflect.DeepEqual(tt.errCols, cols) {
+				t.Fatalf("invalid error tracer rows: %v, cols: %v", rows, cols)
+			}
+		})
+	}
+}
+
+func TestLenientDecodeAll(t *testing.T) {
+	tsv := "1\tx\t-1\n" +
+		"2\ty\t128\n" +
+		"3\tz\t3\n"
+
+	var ret []decodeRecord
+	d := NewDecoder(bytes.NewBufferString(tsv), WithLenient(0))
+	if err := d.DecodeAll(&ret); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	expected := []decodeRecord{{ID: 1, Value: -1}, {ID: 3, Value: 3}}
+	if !reflect.DeepEqual(expected, ret) {
+		t.Fatalf("returned value check failed expected: %v, actual: %v", expected, ret)
+	}
+	if errs := d.Reader().Errors(); len(errs) != 1 || !errors.Is(errs[0], ErrRange) {
+		t.Fatalf("invalid errors %v", errs)
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	long := string(bytes.Repeat([]byte("x"), 10<<10)) // longer than buffer, stored across reservedBuff
+	tsv := "1\t2\n" +
+		"a\t3\n" +
+		"4\t" + long + "\n" +
+		"5\t6\n"
+
+	tests := []struct {
+		name       string
+		withReason bool
+		result     string
+	}{
+		{
+			name:   "raw rows",
+			result: "a\t3\n" + "4\t" + long + "\n",
+		},
+		{
+			name:       "with reason",
+			withReason: true,
+			result: `2: Parse failed at row #2, col #1 as int: strconv.Atoi: parsing "a": invalid syntax` + "\ta\t3\n" +
+				`3: Parse failed at row #3, col #2 as int: strconv.Atoi: parsing "` + long[:64] + `": invalid syntax` + "\t4\t" + long + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			gr := New(bytes.NewBufferString(tsv), WithLenient(0), WithDeadLetter(&buf, tt.withReason))
+			for gr.Next() {
+				gr.Int()
+				gr.Int()
+			}
+
+			if err := gr.Error(); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if buf.String() != tt.result {
+				t.Fatalf("dead letter check failed expected: %q, actual: %q", tt.result, buf.String())
+			}
+		})
+	}
+}
+
+func TestDeadLetterDialect(t *testing.T) {
+	tests := []struct {
+		name    string
+		tsv     string
+		dialect Dialect
+		result  string
+	}{
+		{
+			name:    "pipe",
+			tsv:     "1|2;x|3;",
+			dialect: Dialect{Delimiter: '|', Terminator: ';'},
+			result:  `2: Parse failed at row #2, col #1 as int: strconv.Atoi: parsing "x": invalid syntax` + "|x|3;",
+		},
+		{
+			name:    "colon",
+			tsv:     "1:2\nx:3\n",
+			dialect: Dialect{Delimiter: ':', Terminator: '\n'},
+			result:  `2  Parse failed at row #2, col #1 as int  strconv.Atoi  parsing "x"  invalid syntax` + ":x:3\n",
+		},
+		{
+			name:    "space",
+			tsv:     "1 2\nx 3\n",
+			dialect: Dialect{Delimiter: ' ', Terminator: '\n'},
+			result:  `2:_Parse_failed_at_row_#2,_col_#1_as_int:_strconv.Atoi:_parsing_"x":_invalid_syntax` + " x 3\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			gr := New(bytes.NewBufferString(tt.tsv), WithDialect(tt.dialect), WithLenient(0), WithDeadLetter(&buf, true))
+			for gr.Next() {
+				gr.Int()
+				gr.Int()
+			}
+
+			if err := gr.Error(); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if buf.String() != tt.result {
+				t.Fatalf("dead letter check failed expected: %q, actual: %q", tt.result, buf.String())
+			}
+
+			// dead letter keeps the column layout, the reason and the original columns
+			dr := New(&buf, WithDialect(tt.dialect))
+			if !dr.Next() || len(dr.Fields()) != 3 {
+				t.Fatalf("dead letter can't be read again: %q", dr.Fields())
+			}
+		})
+	}
+}
+
+func TestDeadLetterExtraColumn(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []Option
+		result [][]int
+		dead   string
+	}{
+		{
+			name:   "found by next Next",
+			result: [][]int{{1, 2}, {4, 5}},
+			dead:   "", // already returned as valid
+		},
+		{
+			name:   "rejected with WithColumns",
+			opts:   []Option{WithColumns(2)},
+			result: [][]int{{4, 5}},
+			dead:   "1\t2\t3\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			opts := append([]Option{WithLenient(0), WithDeadLetter(&buf, false)}, tt.opts...)
+			gr := New(bytes.NewBufferString("1\t2\t3\n4\t5\n"), opts...)
+			var ret [][]int
+			for gr.Next() {
+				ret = append(ret, []int{gr.Int(), gr.Int()})
+			}
+
+			if err := gr.Error(); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+			if len(gr.Errors()) != 1 || !errors.Is(gr.Errors()[0], ErrExtraColumn) {
+				t.Fatalf("invalid errors %v", gr.Errors())
+			}
+			if buf.String() != tt.dead {
+				t.Fatalf("dead letter check failed expected: %q, actual: %q", tt.dead, buf.String())
+			}
+		})
+	}
+}
+
+func TestDeadLetterError(t *testing.T) {
+	gr := New(bytes.NewBufferString("a\n1\n"), WithLenient(0), WithDeadLetter(errWriter{}, false))
+	for gr.Next() {
+		gr.Int()
+	}
+
+	if !errors.Is(gr.Error(), ErrIO) {
+		t.Fatalf("invalid error %v", gr.Error())
+	}
+}
@@ -0,0 +1,61 @@
+package gtsv
+
+// LineEnding is the style of line ending.
+type LineEnding int
+
+const (
+	// UnknownLineEnding means no line is read yet
+	UnknownLineEnding LineEnding = iota
+	// LF means lines end with "\n"
+	LF
+	// CRLF means lines end with "\r\n"
+	CRLF
+	// MixedLineEnding means both of LF and CRLF are found
+	MixedLineEnding
+)
+
+// String returns the name of line ending
+func (le LineEnding) String() string {
+	switch le {
+	case LF:
+		return "LF"
+	case CRLF:
+		return "CRLF"
+	case MixedLineEnding:
+		return "Mixed"
+	}
+	return "Unknown"
+}
+
+// LineEnding returns the style of line ending detected in rows read so far.
+// The line ending is detected even if Reader is created with `WithStrictLF()` .
+func (gr *Reader) LineEnding() LineEnding {
+	return gr.lineEnding
+}
+
+// trimCR detects line ending of line, and removes trailing '\r' unless strict LF mode.
+// It does nothing if record terminator is not '\n'.
+// The last line without record terminator has no line ending, so it is not detected.
+func (gr *Reader) trimCR(line []byte) []byte {
+	if gr.terminator != '\n' {
+		return line
+	}
+
+	le := LF
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		le = CRLF
+	}
+
+	if !gr.lastLine {
+		if gr.lineEnding == UnknownLineEnding {
+			gr.lineEnding = le
+		} else if gr.lineEnding != le {
+			gr.lineEnding = MixedLineEnding
+		}
+	}
+
+	if le == CRLF && !gr.strictLF {
+		return line[:len(line)-1]
+	}
+	return line
+}
@@ -0,0 +1,88 @@
+package gtsv
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLineEnding(t *testing.T) {
+	tests := []struct {
+		name       string
+		tsv        string
+		strictLF   bool
+		result     []string
+		lineEnding LineEnding
+		hasError   bool
+	}{
+		{
+			name:       "LF",
+			tsv:        "1\ttrue\n2\tfalse\n",
+			result:     []string{"1 true", "2 false"},
+			lineEnding: LF,
+		},
+		{
+			name:       "CRLF",
+			tsv:        "1\ttrue\r\n2\tfalse\r\n",
+			result:     []string{"1 true", "2 false"},
+			lineEnding: CRLF,
+		},
+		{
+			name:       "mixed",
+			tsv:        "1\ttrue\r\n2\tfalse\n",
+			result:     []string{"1 true", "2 false"},
+			lineEnding: MixedLineEnding,
+		},
+		{
+			name:       "CRLF without last line ending",
+			tsv:        "1\ttrue\r\n2\tfalse",
+			result:     []string{"1 true", "2 false"},
+			lineEnding: CRLF,
+		},
+		{
+			name:       "LF without last line ending",
+			tsv:        "1\ttrue\n2\tfalse",
+			result:     []string{"1 true", "2 false"},
+			lineEnding: LF,
+		},
+		{
+			name:       "empty",
+			tsv:        "",
+			lineEnding: UnknownLineEnding,
+		},
+		{
+			name:       "strict LF",
+			tsv:        "1\ttrue\r\n2\tfalse\r\n",
+			strictLF:   true,
+			result:     []string{"1 false"},
+			lineEnding: CRLF,
+			hasError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []Option
+			if tt.strictLF {
+				opts = append(opts, WithStrictLF())
+			}
+			gr := New(bytes.NewBufferString(tt.tsv), opts...)
+
+			var ret []string
+			for gr.Next() {
+				ret = append(ret, fmt.Sprintf("%d %t", gr.Int(), gr.Bool()))
+			}
+
+			if (gr.Error() != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+			if gr.LineEnding() != tt.lineEnding {
+				t.Fatalf("line ending check failed expected: %s, actual: %s", tt.lineEnding, gr.LineEnding())
+			}
+		})
+	}
+}
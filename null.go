@@ -0,0 +1,177 @@
+package gtsv
+
+import (
+	"bytes"
+	"database/sql"
+)
+
+// defaultNullValues are null values used if `WithNullValues()` is not passed.
+var defaultNullValues = [][]byte{[]byte(""), []byte(`\N`)}
+
+// null returns true and consumes next column if it is null.
+// If error had happened, or the row has no more column, it returns false
+// so that typed method reports the error.
+// In ragged mode, missing column without default is null.
+func (gr *Reader) null() bool {
+	if gr.err != nil {
+		return false
+	}
+
+	var b []byte
+	switch {
+	case gr.col < len(gr.fields):
+		b = gr.fields[gr.col]
+	case gr.ragged && gr.col < len(gr.defaults):
+		b = gr.defaults[gr.col]
+	case gr.ragged:
+		gr.col++
+		return true
+	default:
+		return false
+	}
+	for _, v := range gr.nullValues {
+		if bytes.Equal(b, v) {
+			gr.col++
+			return true
+		}
+	}
+	return false
+}
+
+// IntPtr returns next column as *int.
+// If the column is null or error had happened, it returns nil.
+func (gr *Reader) IntPtr() *int {
+	if gr.null() {
+		return nil
+	}
+	n := gr.Int()
+	if gr.err != nil {
+		return nil
+	}
+	return &n
+}
+
+// Int64Ptr returns next column as *int64.
+// If the column is null or error had happened, it returns nil.
+func (gr *Reader) Int64Ptr() *int64 {
+	if gr.null() {
+		return nil
+	}
+	n := gr.Int64()
+	if gr.err != nil {
+		return nil
+	}
+	return &n
+}
+
+// Uint64Ptr returns next column as *uint64.
+// If the column is null or error had happened, it returns nil.
+func (gr *Reader) Uint64Ptr() *uint64 {
+	if gr.null() {
+		return nil
+	}
+	n := gr.Uint64()
+	if gr.err != nil {
+		return nil
+	}
+	return &n
+}
+
+// Float64Ptr returns next column as *float64.
+// If the column is null or error had happened, it returns nil.
+func (gr *Reader) Float64Ptr() *float64 {
+	if gr.null() {
+		return nil
+	}
+	n := gr.Float64()
+	if gr.err != nil {
+		return nil
+	}
+	return &n
+}
+
+// StringPtr returns next column as *string.
+// If the column is null or error had happened, it returns nil.
+func (gr *Reader) StringPtr() *string {
+	if gr.null() {
+		return nil
+	}
+	s := gr.String()
+	if gr.err != nil {
+		return nil
+	}
+	return &s
+}
+
+// BoolPtr returns next column as *bool.
+// If the column is null or error had happened, it returns nil.
+func (gr *Reader) BoolPtr() *bool {
+	if gr.null() {
+		return nil
+	}
+	b := gr.Bool()
+	if gr.err != nil {
+		return nil
+	}
+	return &b
+}
+
+// NullInt64 returns next column as sql.NullInt64.
+// If the column is null or error had happened, Valid is false.
+func (gr *Reader) NullInt64() sql.NullInt64 {
+	if gr.null() {
+		return sql.NullInt64{}
+	}
+	n := gr.Int64()
+	return sql.NullInt64{Int64: n, Valid: gr.err == nil}
+}
+
+// NullInt32 returns next column as sql.NullInt32.
+// If the column is null or error had happened, Valid is false.
+func (gr *Reader) NullInt32() sql.NullInt32 {
+	if gr.null() {
+		return sql.NullInt32{}
+	}
+	n := gr.Int32()
+	return sql.NullInt32{Int32: n, Valid: gr.err == nil}
+}
+
+// NullFloat64 returns next column as sql.NullFloat64.
+// If the column is null or error had happened, Valid is false.
+func (gr *Reader) NullFloat64() sql.NullFloat64 {
+	if gr.null() {
+		return sql.NullFloat64{}
+	}
+	n := gr.Float64()
+	return sql.NullFloat64{Float64: n, Valid: gr.err == nil}
+}
+
+// NullString returns next column as sql.NullString.
+// If the column is null or error had happened, Valid is false.
+func (gr *Reader) NullString() sql.NullString {
+	if gr.null() {
+		return sql.NullString{}
+	}
+	s := gr.String()
+	return sql.NullString{String: s, Valid: gr.err == nil}
+}
+
+// NullBool returns next column as sql.NullBool.
+// If the column is null or error had happened, Valid is false.
+func (gr *Reader) NullBool() sql.NullBool {
+	if gr.null() {
+		return sql.NullBool{}
+	}
+	b := gr.Bool()
+	return sql.NullBool{Bool: b, Valid: gr.err == nil}
+}
+
+// NullTime returns next column as sql.NullTime, parsed like `Time()` .
+// If the column is null or error had happened, Valid is false.
+func (gr *Reader) NullTime(layout string) sql.NullTime {
+	if gr.null() {
+		return sql.NullTime{}
+	}
+	t := gr.Time(layout)
+	return sql.NullTime{Time: t, Valid: gr.err == nil}
+}
@@ -0,0 +1,119 @@
+package gtsv
+
+import (
+	"bytes"
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNull(t *testing.T) {
+	tsv := "1\t1.5\ta\ttrue\t2018-01-02\n" +
+		"\t\t\t\t\n" +
+		"\\N\t\\N\t\\N\t\\N\t\\N\n"
+
+	gr := New(bytes.NewBufferString(tsv))
+	var ints []sql.NullInt64
+	var floats []sql.NullFloat64
+	var strs []sql.NullString
+	var bools []sql.NullBool
+	var times []sql.NullTime
+	for gr.Next() {
+		ints = append(ints, gr.NullInt64())
+		floats = append(floats, gr.NullFloat64())
+		strs = append(strs, gr.NullString())
+		bools = append(bools, gr.NullBool())
+		times = append(times, gr.NullTime("2006-01-02"))
+	}
+
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if !reflect.DeepEqual([]sql.NullInt64{{Int64: 1, Valid: true}, {}, {}}, ints) {
+		t.Fatalf("returned value check failed: %v", ints)
+	}
+	if !reflect.DeepEqual([]sql.NullFloat64{{Float64: 1.5, Valid: true}, {}, {}}, floats) {
+		t.Fatalf("returned value check failed: %v", floats)
+	}
+	if !reflect.DeepEqual([]sql.NullString{{String: "a", Valid: true}, {}, {}}, strs) {
+		t.Fatalf("returned value check failed: %v", strs)
+	}
+	if !reflect.DeepEqual([]sql.NullBool{{Bool: true, Valid: true}, {}, {}}, bools) {
+		t.Fatalf("returned value check failed: %v", bools)
+	}
+	date := time.Date(2018, 1, 2, 0, 0, 0, 0, time.UTC)
+	if !reflect.DeepEqual([]sql.NullTime{{Time: date, Valid: true}, {}, {}}, times) {
+		t.Fatalf("returned value check failed: %v", times)
+	}
+}
+
+func TestNullValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		opts     []Option
+		result   []*int
+		hasError bool
+	}{
+		{
+			name:   "default",
+			tsv:    "1\n\n\\N\n",
+			result: []*int{intPtr(1), nil, nil},
+		},
+		{
+			name:     "NULL is not null by default",
+			tsv:      "1\nNULL\n",
+			result:   []*int{intPtr(1), nil},
+			hasError: true,
+		},
+		{
+			name:   "custom",
+			tsv:    "1\nNULL\nNA\n",
+			opts:   []Option{WithNullValues("NULL", "NA")},
+			result: []*int{intPtr(1), nil, nil},
+		},
+		{
+			name:     "empty is not null if custom",
+			tsv:      "1\n\n",
+			opts:     []Option{WithNullValues("NULL")},
+			result:   []*int{intPtr(1), nil},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			var ret []*int
+			for gr.Next() {
+				ret = append(ret, gr.IntPtr())
+			}
+
+			if (gr.Error() != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+		})
+	}
+}
+
+func TestNullMissingColumn(t *testing.T) {
+	gr := New(bytes.NewBufferString("1\n"))
+	for gr.Next() {
+		gr.StringPtr()
+		if s := gr.StringPtr(); s != nil {
+			t.Fatalf("missing column should be nil")
+		}
+	}
+	if gr.Error() == nil {
+		t.Fatalf("missing column should be error")
+	}
+}
+
+func intPtr(n int) *int {
+	return &n
+}
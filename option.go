@@ -0,0 +1,187 @@
+package gtsv
+
+import (
+	"io"
+	"reflect"
+	"time"
+)
+
+// Option changes the behavior of Reader.
+// Pass it to `New()` .
+type Option func(*Reader)
+
+// WithHeader makes Reader treat the first row as column names.
+// The header row is not returned by `Next()` , and it is available with `Header()` .
+// Columns can be read by name with methods like `IntByName()` .
+// The header line is counted in row number of errors, so it points the line in the file.
+func WithHeader() Option {
+	return func(gr *Reader) {
+		gr.withHeader = true
+	}
+}
+
+// WithLenient makes Reader continue reading even if a row has error.
+// If a column couldn't be read, the row is marked as failed and `Error()` returns the error
+// until next `Next()` , then `Next()` records it and continues to next row.
+// Recorded errors are available with `Errors()` .
+// Extra column is found by next `Next()` , after the row has been returned,
+// so the row is recorded as failed though it was already read. Use `WithColumns()` or `WithSchema()`
+// to reject such row before it is returned.
+// Reading aborts when maxErrors errors are recorded. If maxErrors is 0, it never aborts.
+// I/O error and unterminated row always abort reading.
+func WithLenient(maxErrors int) Option {
+	return func(gr *Reader) {
+		gr.lenient = true
+		gr.maxErrors = maxErrors
+	}
+}
+
+// WithDeadLetter makes Reader write rows recorded as failed in lenient mode to w.
+// Each row is written exactly as it was read.
+// Extra column found by next `Next()` isn't written, because the row has already been returned as valid.
+// Use `WithColumns()` or `WithSchema()` to reject such row before it is returned.
+// If withReason is true, a column which contains row number and error message is prepended.
+// The delimiter and the record terminator in the message are replaced with ' ' , or '_' if ' ' is one of them.
+// It works only with `WithLenient()` .
+func WithDeadLetter(w io.Writer, withReason bool) Option {
+	return func(gr *Reader) {
+		gr.deadLetter = w
+		gr.deadLetterReason = withReason
+	}
+}
+
+// WithStrictLF makes Reader treat only '\n' as line ending.
+// By default, "\r\n" is treated as line ending too,
+// and with this option '\r' remains at the end of the last column.
+func WithStrictLF() Option {
+	return func(gr *Reader) {
+		gr.strictLF = true
+	}
+}
+
+// WithStrictEOF makes Reader treat the last row without trailing '\n' as error.
+// By default, the remaining bytes at EOF are read as the last row.
+// With this option, truncated stream can be found by `gtsv.ErrUnterminatedRow` .
+func WithStrictEOF() Option {
+	return func(gr *Reader) {
+		gr.strictEOF = true
+	}
+}
+
+// WithDialect makes Reader use the delimiter and record terminator of d.
+func WithDialect(d Dialect) Option {
+	return func(gr *Reader) {
+		gr.delimiter = d.Delimiter
+		gr.terminator = d.Terminator
+	}
+}
+
+// WithQuote makes Reader understand columns quoted with '"', like TSV exported by spreadsheets.
+// Quoted column can contain delimiters and record terminators, and '"' is written as `""` .
+// In this mode, backslash is not escape character.
+func WithQuote() Option {
+	return func(gr *Reader) {
+		gr.quote = true
+	}
+}
+
+// WithComment makes Reader skip lines starting with prefix, like "#".
+// Skipped lines are counted in row number of errors, so it points the line in the file.
+func WithComment(prefix string) Option {
+	return func(gr *Reader) {
+		gr.comment = []byte(prefix)
+	}
+}
+
+// WithSkipEmptyLines makes Reader skip empty lines.
+// Skipped lines are counted in row number of errors, so it points the line in the file.
+func WithSkipEmptyLines() Option {
+	return func(gr *Reader) {
+		gr.skipEmpty = true
+	}
+}
+
+// WithColumns makes `Next()` reject rows which don't have exactly n columns,
+// whichever methods are called to read them.
+// The error is ErrMissingColumn or ErrExtraColumn, and its cause is `*gtsv.ColumnCountError`
+// which has actual and expected number of columns.
+// In lenient mode, rejected rows are recorded and skipped.
+// Header row is not checked. It can't be used with `WithRaggedRows()` .
+func WithColumns(n int) Option {
+	return func(gr *Reader) {
+		gr.columns = n
+	}
+}
+
+// WithSchema makes `Next()` validate every row against s, whichever methods are called to read it.
+// Row which doesn't have the columns of s fails like `WithColumns()` .
+// Column which can't be parsed as its type fails with ErrSyntax or ErrRange,
+// and column which violates constraint fails with ErrConstraint.
+// Errors have the name of column even without header.
+// With `WithHeader()` , header must match names of columns.
+// In lenient mode, invalid rows are recorded and skipped.
+// It can't be used with `WithRaggedRows()` .
+func WithSchema(s Schema) Option {
+	return func(gr *Reader) {
+		gr.schema = &s
+	}
+}
+
+// WithRaggedRows makes Reader accept rows which have fewer or more columns than expected,
+// like old files written before new columns are appended.
+// Missing trailing column is read as defaults[i] for (i+1)-th column, written in the same form as in the file.
+// If no default is given, typed methods return zero-value without error,
+// and methods like `IntPtr()` and `NullInt64()` return null.
+// Unread trailing columns are ignored by `Next()` .
+// `ColumnCount()` returns the number of columns actually present.
+func WithRaggedRows(defaults ...string) Option {
+	return func(gr *Reader) {
+		gr.ragged = true
+		gr.defaults = make([][]byte, len(defaults))
+		for i, v := range defaults {
+			gr.defaults[i] = []byte(v)
+		}
+	}
+}
+
+// WithNullValues makes Reader treat columns equal to one of values as null.
+// Columns are compared before unescaping, so `\N` means backslash and N.
+// By default, empty column and `\N` are null.
+// Null is used by methods like `IntPtr()` and `NullInt64()` .
+func WithNullValues(values ...string) Option {
+	return func(gr *Reader) {
+		gr.nullValues = make([][]byte, len(values))
+		for i, v := range values {
+			gr.nullValues[i] = []byte(v)
+		}
+	}
+}
+
+// WithTimeLayout makes `Time()` use layout when empty layout is passed.
+// By default, it is time.RFC3339.
+func WithTimeLayout(layout string) Option {
+	return func(gr *Reader) {
+		gr.timeLayout = layout
+	}
+}
+
+// WithLocation makes Reader return time.Time in loc.
+// Time without time zone is parsed in loc too.
+// By default, it is time.UTC. loc must not be nil.
+func WithLocation(loc *time.Location) Option {
+	return func(gr *Reader) {
+		gr.location = loc
+	}
+}
+
+// WithParser registers fn as the parser of the type of v, like `WithParser(Currency(""), parseCurrency)` .
+// fn must return the value of the type.
+// Registered types can be read with `Parse()` , and decoded by Decoder.
+func WithParser(v interface{}, fn ParseFunc) Option {
+	return func(gr *Reader) {
+		if gr.parsers == nil {
+			gr.parsers = map[reflect.Type]ParseFunc{}
+		}
+		gr.parsers[reflect.TypeOf(v)] = fn
+	}
+}
@@ -0,0 +1,33 @@
+package gtsv
+
+// Position is the position of a row in the input.
+type Position struct {
+	Record      int   // number of rows returned by `Next()` , starts from 1. Rejected row has the number it would have
+	Line        int   // physical line number where the row starts, starts from 1
+	Offset      int64 // byte offset where the row starts, starts from 0
+	FieldOffset int64 // byte offset where the column starts, -1 if it isn't about a column
+}
+
+// Position returns the position of current row.
+// FieldOffset is the offset of the column read last.
+func (gr *Reader) Position() Position {
+	pos := gr.pos
+	pos.FieldOffset = -1
+	if 0 < gr.col && gr.col <= len(gr.fieldPos) {
+		pos.FieldOffset = pos.Offset + int64(gr.fieldPos[gr.col-1])
+	}
+	return pos
+}
+
+// consume counts line as read.
+// terminated is false if line is the last line without record terminator.
+func (gr *Reader) consume(line []byte, terminated bool) {
+	gr.lines++
+	gr.lineNum = gr.lines
+	gr.lineOffs = gr.offset
+	gr.lastLine = !terminated
+	gr.offset += int64(len(line))
+	if terminated {
+		gr.offset++
+	}
+}
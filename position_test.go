@@ -0,0 +1,133 @@
+package gtsv
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPosition(t *testing.T) {
+	tests := []struct {
+		name string
+		tsv  string
+		opts []Option
+		pos  Position
+	}{
+		{
+			name: "plain",
+			tsv: "1\t2\n" +
+				"3\tx\n",
+			pos: Position{Record: 2, Line: 2, Offset: 4, FieldOffset: 6},
+		},
+		{
+			name: "header and comments",
+			tsv: "a\tb\n" +
+				"# comment\n" +
+				"1\t2\n" +
+				"\n" +
+				"3\tx\n",
+			opts: []Option{WithHeader(), WithComment("#"), WithSkipEmptyLines()},
+			pos:  Position{Record: 2, Line: 5, Offset: 19, FieldOffset: 21},
+		},
+		{
+			name: "CRLF",
+			tsv: "1\t2\r\n" +
+				"3\tx\r\n",
+			pos: Position{Record: 2, Line: 2, Offset: 5, FieldOffset: 7},
+		},
+		{
+			name: "quoted",
+			tsv: "1\t\"2\"\n" +
+				"\"3\"\t\"x\ny\"\n" +
+				"4\t5\n",
+			opts: []Option{WithQuote()},
+			pos:  Position{Record: 2, Line: 2, Offset: 6, FieldOffset: 10},
+		},
+		{
+			name: "missing column",
+			tsv: "1\t2\n" +
+				"3\n",
+			pos: Position{Record: 2, Line: 2, Offset: 4, FieldOffset: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			for gr.Next() {
+				gr.Int()
+				gr.Int()
+			}
+
+			er, ok := gr.Error().(Error)
+			if !ok {
+				t.Fatalf("invalid error %v", gr.Error())
+			}
+			if er.Position() != tt.pos {
+				t.Fatalf("position check failed expected: %+v, actual: %+v", tt.pos, er.Position())
+			}
+		})
+	}
+}
+
+func TestReaderPosition(t *testing.T) {
+	gr := New(bytes.NewBufferString("a\tbb\n" + "ccc\td\n"))
+
+	var positions []Position
+	for gr.Next() {
+		positions = append(positions, gr.Position())
+		gr.Bytes()
+		gr.Bytes()
+		positions = append(positions, gr.Position())
+	}
+
+	expected := []Position{
+		{Record: 1, Line: 1, Offset: 0, FieldOffset: -1},
+		{Record: 1, Line: 1, Offset: 0, FieldOffset: 2},
+		{Record: 2, Line: 2, Offset: 5, FieldOffset: -1},
+		{Record: 2, Line: 2, Offset: 5, FieldOffset: 9},
+	}
+	for i := range expected {
+		if positions[i] != expected[i] {
+			t.Fatalf("position check failed expected: %+v, actual: %+v", expected[i], positions[i])
+		}
+	}
+}
+
+func TestRecordCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		tsv    string
+		opts   []Option
+		record int
+	}{
+		{
+			name:   "after EOF",
+			tsv:    "1\n2\n",
+			record: 2,
+		},
+		{
+			name:   "rejected rows are not counted",
+			tsv:    "1\n2\t3\n4\n",
+			opts:   []Option{WithColumns(1), WithLenient(0)},
+			record: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			var records []int
+			for gr.Next() {
+				records = append(records, gr.Position().Record)
+				gr.Int()
+			}
+
+			if err := gr.Error(); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if n := gr.Position().Record; n != tt.record || records[len(records)-1] != tt.record {
+				t.Fatalf("record count check failed expected: %d, actual: %d, %v", tt.record, n, records)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package gtsv
+
+import (
+	"bytes"
+)
+
+// readRecord returns next row.
+// Comment lines and empty lines are skipped if it's enabled.
+// In quote mode, a row may span lines if quoted column contains record terminator.
+func (gr *Reader) readRecord() ([]byte, bool) {
+	line, ok := gr.readLine()
+	for ok && gr.skipLine(line) {
+		line, ok = gr.readLine()
+	}
+	if ok {
+		gr.row = gr.lineNum // row number is the line number where the row starts
+		gr.pos.Line = gr.lineNum
+		gr.pos.Offset = gr.lineOffs
+	}
+	if !ok || !gr.quote || !quoteOpen(line, gr.delimiter) {
+		return line, ok
+	}
+
+	// line will be overwritten by next readLine, so copy it
+	record := append(gr.recordBuff[:0], line...)
+	for quoteOpen(record, gr.delimiter) {
+		line, ok = gr.readLine()
+		if !ok {
+			if gr.err == nil {
+				gr.fail(ErrUnterminatedQuote, record, nil) // row is where the quote opened
+			}
+			gr.recordBuff = record
+			return nil, false
+		}
+		record = append(record, gr.terminator)
+		record = append(record, line...)
+	}
+	gr.recordBuff = record
+	return record, true
+}
+
+// quoteOpen returns line ends in quoted column.
+func quoteOpen(line []byte, delim byte) bool {
+	for {
+		if len(line) == 0 || line[0] != '"' {
+			// not quoted
+			n := bytes.IndexByte(line, delim)
+			if n < 0 {
+				return false
+			}
+			line = line[n+1:]
+			continue
+		}
+
+		i := 1
+		for {
+			n := bytes.IndexByte(line[i:], '"')
+			if n < 0 {
+				return true
+			}
+			i += n + 1
+			if i < len(line) && line[i] == '"' {
+				i++ // `""` is escaped quote
+				continue
+			}
+			break
+		}
+
+		n := bytes.IndexByte(line[i:], delim)
+		if n < 0 {
+			return false
+		}
+		line = line[i+n+1:]
+	}
+}
+
+// splitQuoted splits line by delimiter into fields, and unquotes quoted columns.
+// Bytes after closing quote are kept as they are.
+func (gr *Reader) splitQuoted(line []byte) {
+	fields := gr.fields[:0]
+	fieldPos := gr.fieldPos[:0]
+	pos := 0
+	if cap(gr.quoteBuff) < len(line) {
+		gr.quoteBuff = make([]byte, 0, len(line)) // never grows, so columns are not moved
+	}
+	buf := gr.quoteBuff[:0]
+
+	for {
+		fieldPos = append(fieldPos, pos)
+		if len(line) == 0 || line[0] != '"' {
+			n := bytes.IndexByte(line, gr.delimiter)
+			if n < 0 {
+				gr.fields = append(fields, line)
+				gr.fieldPos = fieldPos
+				return
+			}
+			fields = append(fields, line[:n])
+			line = line[n+1:]
+			pos += n + 1
+			continue
+		}
+
+		start := len(buf)
+		i := 1
+		for i < len(line) {
+			if line[i] == '"' {
+				if i+1 < len(line) && line[i+1] == '"' {
+					buf = append(buf, '"')
+					i += 2
+					continue
+				}
+				i++ // closing quote
+				break
+			}
+			buf = append(buf, line[i])
+			i++
+		}
+
+		n := bytes.IndexByte(line[i:], gr.delimiter)
+		if n < 0 {
+			buf = append(buf, line[i:]...)
+			gr.fields = append(fields, buf[start:len(buf):len(buf)])
+			gr.fieldPos = fieldPos
+			return
+		}
+		buf = append(buf, line[i:i+n]...)
+		fields = append(fields, buf[start:len(buf):len(buf)])
+		line = line[i+n+1:]
+		pos += i + n + 1
+	}
+}
@@ -0,0 +1,123 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		col      int
+		result   [][]string
+		hasError bool
+		errRow   int
+	}{
+		{
+			name: "no quote",
+			tsv: "a\tb\n" +
+				"c\td\n",
+			col:    2,
+			result: [][]string{{"a", "b"}, {"c", "d"}},
+		},
+		{
+			name: "quoted",
+			tsv: "\"a\tb\"\t\"c\"\n" +
+				"\"say \"\"hi\"\"\"\t\"\"\n",
+			col:    2,
+			result: [][]string{{"a\tb", "c"}, {"say \"hi\"", ""}},
+		},
+		{
+			name: "spanning lines",
+			tsv: "1\t\"first\nsecond\n\"\n" +
+				"2\t\"third\"\n",
+			col:    2,
+			result: [][]string{{"1", "first\nsecond\n"}, {"2", "third"}},
+		},
+		{
+			name: "CRLF",
+			tsv: "1\t\"a\r\nb\"\r\n" +
+				"2\tc\r\n",
+			col:    2,
+			result: [][]string{{"1", "a\r\nb"}, {"2", "c"}},
+		},
+		{
+			name:   "backslash is not escape",
+			tsv:    "C:\\new\t\"a\\tb\"\n",
+			col:    2,
+			result: [][]string{{"C:\\new", "a\\tb"}},
+		},
+		{
+			name:   "quote in the middle",
+			tsv:    "a\"b\t\"c\"d\n",
+			col:    2,
+			result: [][]string{{"a\"b", "cd"}},
+		},
+		{
+			name: "unterminated quote",
+			tsv: "1\tok\n" +
+				"2\t\"never closed\n" +
+				"3\tx\n",
+			col:      2,
+			result:   [][]string{{"1", "ok"}},
+			hasError: true,
+			errRow:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), WithQuote())
+			var ret [][]string
+			for gr.Next() {
+				var line []string
+				for i := 0; i < tt.col; i++ {
+					line = append(line, gr.String())
+				}
+				ret = append(ret, line)
+			}
+
+			err := gr.Error()
+			if (err != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", err)
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %q, actual: %q", tt.result, ret)
+			}
+			if err == nil {
+				return
+			}
+
+			if !errors.Is(err, ErrUnterminatedQuote) {
+				t.Fatalf("invalid error %s", err)
+			}
+			if er := err.(Error); er.Row() != tt.errRow {
+				t.Fatalf("invalid error tracer row: %d", er.Row())
+			}
+		})
+	}
+}
+
+func TestQuoteLongRow(t *testing.T) {
+	long := strings.Repeat("x\n", 8<<10) // longer than buffer
+	tsv := "id\tmemo\n" +
+		"1\t\"" + long + "\"\n" +
+		"2\t\"short\"\n"
+
+	gr := New(bytes.NewBufferString(tsv), WithHeader(), WithQuote())
+	var ret []string
+	for gr.Next() {
+		ret = append(ret, gr.StringByName("memo"))
+	}
+
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if !reflect.DeepEqual([]string{long, "short"}, ret) {
+		t.Fatalf("returned value check failed")
+	}
+}
@@ -0,0 +1,16 @@
+package gtsv
+
+// ColumnCount returns the number of columns present in current row.
+// It doesn't count missing columns filled by defaults of `WithRaggedRows()` .
+func (gr *Reader) ColumnCount() int {
+	return len(gr.fields)
+}
+
+// missingColumn returns the default of current column, which is missing in ragged mode.
+// If no default is given, it returns false.
+func (gr *Reader) missingColumn() ([]byte, bool) {
+	if gr.col > len(gr.defaults) {
+		return nil, false
+	}
+	return gr.defaults[gr.col-1], true
+}
@@ -0,0 +1,109 @@
+package gtsv
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestRaggedRows(t *testing.T) {
+	type row struct {
+		id    int
+		name  string
+		score *int
+		count int
+	}
+
+	tests := []struct {
+		name     string
+		tsv      string
+		opts     []Option
+		result   []row
+		counts   []int
+		hasError bool
+	}{
+		{
+			name:     "not ragged",
+			tsv:      "1\ta\t10\t2\n2\tb\n",
+			result:   []row{{id: 1, name: "a", score: intPtr(10), count: 2}, {id: 2, name: "b"}},
+			counts:   []int{4, 2},
+			hasError: true,
+		},
+		{
+			name:   "missing columns",
+			tsv:    "1\ta\t10\t2\n2\tb\n3\n",
+			opts:   []Option{WithRaggedRows()},
+			result: []row{{id: 1, name: "a", score: intPtr(10), count: 2}, {id: 2, name: "b"}, {id: 3}},
+			counts: []int{4, 2, 1},
+		},
+		{
+			name:   "extra columns",
+			tsv:    "1\ta\t10\t2\tnew\tnewer\n",
+			opts:   []Option{WithRaggedRows()},
+			result: []row{{id: 1, name: "a", score: intPtr(10), count: 2}},
+			counts: []int{6},
+		},
+		{
+			name: "defaults",
+			tsv:  "1\ta\t10\t2\n2\n",
+			opts: []Option{WithRaggedRows("0", "none", "\\N", "5")},
+			result: []row{
+				{id: 1, name: "a", score: intPtr(10), count: 2},
+				{id: 2, name: "none", count: 5},
+			},
+			counts: []int{4, 1},
+		},
+		{
+			name:     "invalid default",
+			tsv:      "1\n",
+			opts:     []Option{WithRaggedRows("0", "", "", "x")},
+			result:   []row{{id: 1}},
+			counts:   []int{1},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			var ret []row
+			var counts []int
+			for gr.Next() {
+				var r row
+				r.id = gr.Int()
+				r.name = gr.String()
+				r.score = gr.IntPtr()
+				r.count = gr.Int()
+				ret = append(ret, r)
+				counts = append(counts, gr.ColumnCount())
+			}
+
+			if (gr.Error() != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+			if !reflect.DeepEqual(tt.counts, counts) {
+				t.Fatalf("ColumnCount() check failed expected: %v, actual: %v", tt.counts, counts)
+			}
+		})
+	}
+}
+
+func TestRaggedRowsScan(t *testing.T) {
+	gr := New(bytes.NewBufferString("1\ta\n2\tb\tc\n"), WithRaggedRows("", "", "z"))
+	var rows [][]string
+	for gr.Next() {
+		var a, b, c string
+		if err := gr.Scan(&a, &b, &c); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		rows = append(rows, []string{a, b, c})
+	}
+
+	expected := [][]string{{"1", "a", "z"}, {"2", "b", "c"}}
+	if !reflect.DeepEqual(expected, rows) {
+		t.Fatalf("returned value check failed expected: %v, actual: %v", expected, rows)
+	}
+}
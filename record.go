@@ -0,0 +1,60 @@
+package gtsv
+
+// Fields returns all columns of current row, unescaped.
+// Returned slices refer the buffer of Reader, so they are overwritten by next `Next()` .
+// Use `Record()` to keep them.
+// All columns are read, so `Next()` doesn't report unread column.
+// If error had happened, it returns nil.
+func (gr *Reader) Fields() [][]byte {
+	if gr.err != nil {
+		return nil
+	}
+
+	fields := gr.rowBuff[:0]
+	for _, f := range gr.fields {
+		fields = append(fields, gr.unescape(f))
+	}
+	gr.rowBuff = fields
+	gr.col = len(gr.fields)
+	return fields
+}
+
+// Strings returns all columns of current row as strings, unescaped.
+// All columns are read like `Fields()` .
+// If error had happened, it returns nil.
+func (gr *Reader) Strings() []string {
+	fields := gr.Fields()
+	if fields == nil {
+		return nil
+	}
+
+	ss := make([]string, len(fields))
+	for i, f := range fields {
+		ss[i] = string(f)
+	}
+	return ss
+}
+
+// Record returns a copy of all columns of current row, unescaped.
+// Unlike `Fields()` , it can be kept after `Next()` .
+// All columns are read like `Fields()` .
+// If error had happened, it returns nil.
+func (gr *Reader) Record() [][]byte {
+	fields := gr.Fields()
+	if fields == nil {
+		return nil
+	}
+
+	n := 0
+	for _, f := range fields {
+		n += len(f)
+	}
+	buf := make([]byte, 0, n) // all columns share one allocation
+	rec := make([][]byte, len(fields))
+	for i, f := range fields {
+		start := len(buf)
+		buf = append(buf, f...)
+		rec[i] = buf[start:len(buf):len(buf)]
+	}
+	return rec
+}
@@ -0,0 +1,83 @@
+package gtsv
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		tsv    string
+		opts   []Option
+		result [][]string
+	}{
+		{
+			name:   "simple",
+			tsv:    "a\tb\tc\n1\t\t3\n",
+			result: [][]string{{"a", "b", "c"}, {"1", "", "3"}},
+		},
+		{
+			name:   "escaped",
+			tsv:    "a\\tb\tc\\\\d\n",
+			result: [][]string{{"a\tb", "c\\d"}},
+		},
+		{
+			name:   "quoted",
+			tsv:    "\"a\tb\"\t\"c\"\"d\"\n",
+			opts:   []Option{WithQuote()},
+			result: [][]string{{"a\tb", "c\"d"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			var fields, strs [][]string
+			for gr.Next() {
+				var row []string
+				for _, f := range gr.Fields() {
+					row = append(row, string(f))
+				}
+				fields = append(fields, row)
+				strs = append(strs, gr.Strings())
+			}
+
+			if err := gr.Error(); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if !reflect.DeepEqual(tt.result, fields) {
+				t.Fatalf("Fields() check failed expected: %q, actual: %q", tt.result, fields)
+			}
+			if !reflect.DeepEqual(tt.result, strs) {
+				t.Fatalf("Strings() check failed expected: %q, actual: %q", tt.result, strs)
+			}
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	gr := New(bytes.NewBufferString("a\tb\\nc\n1\t2\n"))
+	var recs [][][]byte
+	for gr.Next() {
+		recs = append(recs, gr.Record())
+	}
+
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	expected := [][][]byte{{[]byte("a"), []byte("b\nc")}, {[]byte("1"), []byte("2")}}
+	if !reflect.DeepEqual(expected, recs) {
+		t.Fatalf("returned value check failed expected: %q, actual: %q", expected, recs)
+	}
+}
+
+func TestFieldsAfterError(t *testing.T) {
+	gr := New(bytes.NewBufferString("x\t1\n"))
+	gr.Next()
+	gr.Int()
+	if fields := gr.Fields(); fields != nil {
+		t.Fatalf("Fields() after error should be nil: %q", fields)
+	}
+}
@@ -0,0 +1,124 @@
+package gtsv
+
+import (
+	"database/sql"
+	"encoding"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Scan reads all columns of current row into dest, like `database/sql.Rows.Scan()` .
+// dest must have the same number of elements as the row has columns,
+// except in ragged mode.
+// Each element must be a pointer to one of int, int8, int16, int32, int64,
+// uint, uint8, uint16, uint32, uint64, float32, float64, string, []byte, bool,
+// time.Time, time.Duration and interface{}, or sql.Scanner, encoding.TextUnmarshaler,
+// or a pointer to the type registered by `WithParser()` .
+// time.Time is parsed with the layout passed to `WithTimeLayout()` .
+// sql.Scanner gets nil for null column, otherwise the column as string.
+// sql.NullTime is read by `NullTime()` with the layout and location of options.
+// []byte and interface{} get a copy of the column, so they can be kept after `Next()` .
+// It returns the same error as `Error()` .
+func (gr *Reader) Scan(dest ...interface{}) error {
+	if gr.err != nil {
+		return gr.err
+	}
+
+	gr.col = 0
+	switch {
+	case gr.ragged:
+		// missing columns are defaults, and extra columns are ignored
+	case !gr.failColumnCount(len(dest)):
+		return gr.err
+	}
+
+	for _, d := range dest {
+		gr.scan(d)
+		if gr.err != nil {
+			return gr.err
+		}
+	}
+	return nil
+}
+
+// scan reads next column into d.
+func (gr *Reader) scan(d interface{}) {
+	switch d := d.(type) {
+	case *sql.NullTime:
+		*d = gr.NullTime("") // parsed like time.Time, not as string
+	case sql.Scanner:
+		gr.scanScanner(d)
+	case *int:
+		*d = gr.Int()
+	case *int8:
+		*d = gr.Int8()
+	case *int16:
+		*d = gr.Int16()
+	case *int32:
+		*d = gr.Int32()
+	case *int64:
+		*d = gr.Int64()
+	case *uint:
+		*d = gr.Uint()
+	case *uint8:
+		*d = gr.Uint8()
+	case *uint16:
+		*d = gr.Uint16()
+	case *uint32:
+		*d = gr.Uint32()
+	case *uint64:
+		*d = gr.Uint64()
+	case *float32:
+		*d = gr.Float32()
+	case *float64:
+		*d = gr.Float64()
+	case *string:
+		*d = gr.String()
+	case *[]byte:
+		if b := gr.Bytes(); b != nil {
+			*d = append([]byte{}, b...) // copy, because buffer will be overwritten
+		}
+	case *bool:
+		*d = gr.Bool()
+	case *time.Time:
+		*d = gr.Time("")
+	case *time.Duration:
+		*d = gr.Duration()
+	case *interface{}:
+		if b, ok := gr.column(); ok {
+			*d = string(gr.unescape(b))
+		}
+	case encoding.TextUnmarshaler:
+		gr.Value(d)
+	default:
+		rv := reflect.ValueOf(d)
+		if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+			if _, ok := gr.parsers[rv.Type().Elem()]; ok {
+				gr.Parse(d)
+				return
+			}
+		}
+		gr.col++
+		gr.err = fmt.Errorf("gtsv: Scan doesn't support %T at col #%d", d, gr.col)
+	}
+}
+
+// scanScanner reads next column into s.
+// Null column is passed as nil.
+func (gr *Reader) scanScanner(s sql.Scanner) {
+	if gr.null() {
+		if err := s.Scan(nil); err != nil {
+			gr.failParse(typeName(reflect.TypeOf(s)), nil, err)
+		}
+		return
+	}
+
+	b, ok := gr.column()
+	if !ok {
+		return
+	}
+	if err := s.Scan(string(gr.unescape(b))); err != nil {
+		gr.failParse(typeName(reflect.TypeOf(s)), b, err)
+	}
+}
@@ -0,0 +1,118 @@
+package gtsv
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestScan(t *testing.T) {
+	tsv := "1\t-2\t3.5\tfoo\\tbar\tbaz\ttrue\t2018-01-02T03:04:05Z\t1m\t\\N\t7\tUSD\t2020-01-02T03:04:05Z\n"
+
+	var (
+		i    int
+		i64  int64
+		f    float64
+		s    string
+		b    []byte
+		ok   bool
+		tm   time.Time
+		d    time.Duration
+		ns   sql.NullString
+		ni   sql.NullInt64
+		nt   sql.NullTime
+		c    currency
+		rows int
+	)
+	gr := New(bytes.NewBufferString(tsv))
+	for gr.Next() {
+		if err := gr.Scan(&i, &i64, &f, &s, &b, &ok, &tm, &d, &ns, &ni, &c, &nt); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		rows++
+	}
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if rows != 1 || i != 1 || i64 != -2 || f != 3.5 || s != "foo\tbar" || string(b) != "baz" || !ok ||
+		!tm.Equal(time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)) || d != time.Minute ||
+		ns.Valid || ni != (sql.NullInt64{Int64: 7, Valid: true}) || c != "USD" ||
+		!nt.Valid || !nt.Time.Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Fatalf("returned value check failed: %v %v %v %q %q %v %v %v %v %v %v %v", i, i64, f, s, b, ok, tm, d, ns, ni, c, nt)
+	}
+}
+
+func TestScanError(t *testing.T) {
+	tests := []struct {
+		name string
+		tsv  string
+		dest func() []interface{}
+		kind error
+		col  int
+	}{
+		{
+			name: "missing column",
+			tsv:  "1\n",
+			dest: func() []interface{} { var a, b int; return []interface{}{&a, &b} },
+			kind: ErrMissingColumn,
+			col:  2,
+		},
+		{
+			name: "extra column",
+			tsv:  "1\t2\t3\n",
+			dest: func() []interface{} { var a, b int; return []interface{}{&a, &b} },
+			kind: ErrExtraColumn,
+			col:  3,
+		},
+		{
+			name: "syntax",
+			tsv:  "1\tx\n",
+			dest: func() []interface{} { var a, b int; return []interface{}{&a, &b} },
+			kind: ErrSyntax,
+			col:  2,
+		},
+		{
+			name: "null time",
+			tsv:  "1\t2020/01/02\n",
+			dest: func() []interface{} { var a int; var b sql.NullTime; return []interface{}{&a, &b} },
+			kind: ErrSyntax,
+			col:  2,
+		},
+		{
+			name: "scanner",
+			tsv:  "1\tx\n",
+			dest: func() []interface{} { var a int; var b sql.NullInt64; return []interface{}{&a, &b} },
+			kind: ErrSyntax,
+			col:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv))
+			gr.Next()
+			err := gr.Scan(tt.dest()...)
+
+			var e Error
+			if !errors.As(err, &e) || !errors.Is(err, tt.kind) || e.Col() != tt.col || e.Row() != 1 {
+				t.Fatalf("error check failed: %v", err)
+			}
+			if !reflect.DeepEqual(err, gr.Error()) {
+				t.Fatalf("Scan() and Error() returned different errors: %v, %v", err, gr.Error())
+			}
+		})
+	}
+}
+
+func TestScanUnsupported(t *testing.T) {
+	gr := New(bytes.NewBufferString("1\n"))
+	gr.Next()
+	var v struct{}
+	if err := gr.Scan(&v); err == nil {
+		t.Fatalf("unsupported type should be error")
+	}
+}
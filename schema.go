@@ -0,0 +1,267 @@
+package gtsv
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+)
+
+// ColumnType is the type of column declared in Schema.
+type ColumnType int
+
+// Types of column.
+const (
+	TypeString  ColumnType = iota // any text
+	TypeInt                       // int64
+	TypeUint                      // uint64
+	TypeUint8                     // uint8
+	TypeFloat64                   // float64
+	TypeBool                      // parsed like `Bool()`
+	TypeTime                      // parsed like `Time()` with Column.Layout
+	TypeEnum                      // one of Column.Values
+)
+
+var columnTypeNames = [...]string{
+	TypeString:  "string",
+	TypeInt:     "int",
+	TypeUint:    "uint",
+	TypeUint8:   "uint8",
+	TypeFloat64: "float64",
+	TypeBool:    "bool",
+	TypeTime:    "time",
+	TypeEnum:    "enum",
+}
+
+// String returns the name of t, like "int".
+func (t ColumnType) String() string {
+	if t < 0 || int(t) >= len(columnTypeNames) {
+		return "ColumnType(" + strconv.Itoa(int(t)) + ")"
+	}
+	return columnTypeNames[t]
+}
+
+// isText returns t is TypeString or TypeEnum, whose column is text as it is.
+func (t ColumnType) isText() bool {
+	return t == TypeString || t == TypeEnum
+}
+
+// ParseColumnType returns ColumnType named s, like "int".
+func ParseColumnType(s string) (ColumnType, error) {
+	for t, name := range columnTypeNames {
+		if name == s {
+			return ColumnType(t), nil
+		}
+	}
+	return 0, fmt.Errorf("gtsv: unknown column type %q", s)
+}
+
+// Column declares a column of Schema.
+// Constraints are checked only if they are set.
+type Column struct {
+	Name     string         // column name, checked against header with `WithHeader()`
+	Type     ColumnType     // type of column
+	Nullable bool           // column can be null, see `WithNullValues()` . TypeString and TypeEnum column isn't null unless Nullable, so empty string is a string
+	Layout   string         // layout of TypeTime, empty means the layout of `WithTimeLayout()`
+	Min      *float64       // minimum value of numeric column, integral bound is compared exactly with integer column
+	Max      *float64       // maximum value of numeric column, integral bound is compared exactly with integer column
+	Pattern  *regexp.Regexp // unescaped column must match
+	MaxLen   int            // maximum number of characters of unescaped column, 0 means no limit
+	Values   []string       // allowed values, required for TypeEnum
+}
+
+// Schema declares columns of rows.
+// Pass it to `WithSchema()` so that Reader validates every row.
+type Schema struct {
+	Columns []Column
+}
+
+// ConstraintError is the cause of ErrConstraint.
+// It is available with `errors.As()` .
+type ConstraintError struct {
+	Constraint string // violated constraint, one of "not null", "min", "max", "pattern", "max length" and "values"
+	Detail     string // what was wrong
+}
+
+// Error returns error message
+func (e *ConstraintError) Error() string {
+	return "violates " + e.Constraint + ": " + e.Detail
+}
+
+// validate returns error if s can't be used.
+func (s *Schema) validate() error {
+	if len(s.Columns) == 0 {
+		return fmt.Errorf("gtsv: schema has no column")
+	}
+	for i, c := range s.Columns {
+		if c.Type < 0 || int(c.Type) >= len(columnTypeNames) {
+			return fmt.Errorf("gtsv: column #%d has unknown type %s", i+1, c.Type)
+		}
+		if c.Type == TypeEnum && len(c.Values) == 0 {
+			return fmt.Errorf("gtsv: column #%d is enum, but has no values", i+1)
+		}
+		if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+			return fmt.Errorf("gtsv: column #%d has min %g greater than max %g", i+1, *c.Min, *c.Max)
+		}
+	}
+	return nil
+}
+
+// checkHeader returns header matches column names of schema.
+func (gr *Reader) checkHeader() bool {
+	for i, c := range gr.schema.Columns {
+		if c.Name == "" {
+			continue
+		}
+		if i >= len(gr.header) || gr.header[i] != c.Name {
+			gr.err = fmt.Errorf("gtsv: header doesn't match schema, column #%d must be %q", i+1, c.Name)
+			return false
+		}
+	}
+	return true
+}
+
+// checkSchema returns current row is valid for the schema passed to `WithSchema()` .
+// If not, the row is marked as failed.
+// Column cursor is reset, so the row can be read as usual.
+func (gr *Reader) checkSchema() bool {
+	if gr.schema == nil {
+		return true
+	}
+	if !gr.failColumnCount(len(gr.schema.Columns)) {
+		return false
+	}
+
+	gr.col = 0
+	for i := range gr.schema.Columns {
+		if !gr.checkColumn(&gr.schema.Columns[i]) {
+			return false
+		}
+	}
+	gr.col = 0
+	return true
+}
+
+// checkColumn returns next column is valid for c.
+func (gr *Reader) checkColumn(c *Column) bool {
+	raw := gr.fields[gr.col]
+	if (c.Nullable || !c.Type.isText()) && gr.null() {
+		if c.Nullable {
+			return true
+		}
+		gr.failConstraint(c, raw, "not null", "column is null")
+		return false
+	}
+
+	var num string              // value of numeric column for error message
+	var cmp func(b float64) int // compares value of numeric column with bound b
+	switch c.Type {
+	case TypeInt:
+		n := gr.Int64()
+		num = strconv.FormatInt(n, 10)
+		cmp = func(b float64) int { return compareInt(n, b) }
+	case TypeUint:
+		n := gr.Uint64()
+		num = strconv.FormatUint(n, 10)
+		cmp = func(b float64) int { return compareUint(n, b) }
+	case TypeUint8:
+		n := uint64(gr.Uint8())
+		num = strconv.FormatUint(n, 10)
+		cmp = func(b float64) int { return compareUint(n, b) }
+	case TypeFloat64:
+		f := gr.Float64()
+		num = strconv.FormatFloat(f, 'g', -1, 64)
+		cmp = func(b float64) int { return compareFloat(f, b) }
+	case TypeBool:
+		gr.Bool()
+	case TypeTime:
+		gr.Time(c.Layout)
+	default:
+		gr.column()
+	}
+	if gr.err != nil {
+		return false
+	}
+
+	if cmp != nil {
+		if c.Min != nil && cmp(*c.Min) < 0 {
+			gr.failConstraint(c, raw, "min", fmt.Sprintf("%s is less than %g", num, *c.Min))
+			return false
+		}
+		if c.Max != nil && cmp(*c.Max) > 0 {
+			gr.failConstraint(c, raw, "max", fmt.Sprintf("%s is greater than %g", num, *c.Max))
+			return false
+		}
+	}
+
+	text := gr.unescape(raw)
+	if c.MaxLen > 0 && utf8.RuneCount(text) > c.MaxLen {
+		gr.failConstraint(c, raw, "max length", fmt.Sprintf("%d characters is longer than %d", utf8.RuneCount(text), c.MaxLen))
+		return false
+	}
+	if c.Pattern != nil && !c.Pattern.Match(text) {
+		gr.failConstraint(c, raw, "pattern", fmt.Sprintf("doesn't match %s", c.Pattern))
+		return false
+	}
+	if len(c.Values) > 0 && !containsString(c.Values, bytesToString(text)) {
+		gr.failConstraint(c, raw, "values", fmt.Sprintf("%q is not one of %q", text, c.Values))
+		return false
+	}
+	return true
+}
+
+// failConstraint stores the error which current column violates constraint of c.
+func (gr *Reader) failConstraint(c *Column, raw []byte, constraint, detail string) {
+	gr.fail(ErrConstraint, raw, &ConstraintError{Constraint: constraint, Detail: detail}).typ = c.Type.String()
+}
+
+// compareInt compares n with bound b, and returns -1, 0 or 1.
+// Integral b is compared as int64, so n isn't rounded to float64.
+func compareInt(n int64, b float64) int {
+	if b != math.Trunc(b) || b < math.MinInt64 || b >= math.MaxInt64 {
+		return compareFloat(float64(n), b)
+	}
+	switch i := int64(b); {
+	case n < i:
+		return -1
+	case n > i:
+		return 1
+	}
+	return 0
+}
+
+// compareUint compares n with bound b, and returns -1, 0 or 1.
+// Integral b is compared as uint64, so n isn't rounded to float64.
+func compareUint(n uint64, b float64) int {
+	if b != math.Trunc(b) || b < 0 || b >= math.MaxUint64 {
+		return compareFloat(float64(n), b)
+	}
+	switch u := uint64(b); {
+	case n < u:
+		return -1
+	case n > u:
+		return 1
+	}
+	return 0
+}
+
+// compareFloat compares f with bound b, and returns -1, 0 or 1.
+func compareFloat(f, b float64) int {
+	switch {
+	case f < b:
+		return -1
+	case f > b:
+		return 1
+	}
+	return 0
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,272 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+var testSchema = Schema{Columns: []Column{
+	{Name: "id", Type: TypeUint, Min: float64Ptr(1)},
+	{Name: "name", Type: TypeString, MaxLen: 5, Pattern: regexp.MustCompile(`^[a-z]+$`)},
+	{Name: "age", Type: TypeUint8, Nullable: true, Max: float64Ptr(150)},
+	{Name: "score", Type: TypeFloat64, Min: float64Ptr(0), Max: float64Ptr(1)},
+	{Name: "active", Type: TypeBool},
+	{Name: "joined", Type: TypeTime, Layout: "2006-01-02"},
+	{Name: "plan", Type: TypeEnum, Values: []string{"free", "pro"}},
+}}
+
+func TestSchema(t *testing.T) {
+	tests := []struct {
+		name       string
+		tsv        string
+		kind       error
+		col        int
+		colName    string
+		constraint string
+	}{
+		{
+			name: "valid",
+			tsv:  "1\tbob\t20\t0.5\ttrue\t2018-01-02\tfree\n2\talice\t\\N\t1\tfalse\t2018-01-03\tpro\n",
+		},
+		{
+			name:       "min",
+			tsv:        "0\tbob\t20\t0.5\ttrue\t2018-01-02\tfree\n",
+			kind:       ErrConstraint,
+			col:        1,
+			colName:    "id",
+			constraint: "min",
+		},
+		{
+			name:       "max length",
+			tsv:        "1\tbobby\t20\t0.5\ttrue\t2018-01-02\tfree\n1\tbobbie\t20\t0.5\ttrue\t2018-01-02\tfree\n",
+			kind:       ErrConstraint,
+			col:        2,
+			colName:    "name",
+			constraint: "max length",
+		},
+		{
+			name:       "pattern",
+			tsv:        "1\tBob\t20\t0.5\ttrue\t2018-01-02\tfree\n",
+			kind:       ErrConstraint,
+			col:        2,
+			colName:    "name",
+			constraint: "pattern",
+		},
+		{
+			name:       "not null",
+			tsv:        "1\tbob\t20\t\ttrue\t2018-01-02\tfree\n",
+			kind:       ErrConstraint,
+			col:        4,
+			colName:    "score",
+			constraint: "not null",
+		},
+		{
+			name:    "range",
+			tsv:     "1\tbob\t256\t0.5\ttrue\t2018-01-02\tfree\n",
+			kind:    ErrRange,
+			col:     3,
+			colName: "age",
+		},
+		{
+			name:       "max",
+			tsv:        "1\tbob\t20\t1.5\ttrue\t2018-01-02\tfree\n",
+			kind:       ErrConstraint,
+			col:        4,
+			colName:    "score",
+			constraint: "max",
+		},
+		{
+			name:    "bool",
+			tsv:     "1\tbob\t20\t0.5\tyes\t2018-01-02\tfree\n",
+			kind:    ErrSyntax,
+			col:     5,
+			colName: "active",
+		},
+		{
+			name:    "time",
+			tsv:     "1\tbob\t20\t0.5\ttrue\t2018/01/02\tfree\n",
+			kind:    ErrSyntax,
+			col:     6,
+			colName: "joined",
+		},
+		{
+			name:       "enum",
+			tsv:        "1\tbob\t20\t0.5\ttrue\t2018-01-02\tenterprise\n",
+			kind:       ErrConstraint,
+			col:        7,
+			colName:    "plan",
+			constraint: "values",
+		},
+		{
+			name:    "column count",
+			tsv:     "1\tbob\t20\t0.5\ttrue\t2018-01-02\n",
+			kind:    ErrMissingColumn,
+			col:     7,
+			colName: "plan",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), WithSchema(testSchema))
+			for gr.Next() {
+				gr.SkipRest()
+			}
+
+			if tt.kind == nil {
+				if err := gr.Error(); err != nil {
+					t.Fatalf("unexpected error %s", err)
+				}
+				return
+			}
+
+			var e Error
+			if !errors.As(gr.Error(), &e) || !errors.Is(gr.Error(), tt.kind) || e.Col() != tt.col || e.Name() != tt.colName {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if tt.constraint != "" {
+				var ce *ConstraintError
+				if !errors.As(gr.Error(), &ce) || ce.Constraint != tt.constraint {
+					t.Fatalf("constraint check failed: %v", gr.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestSchemaRead(t *testing.T) {
+	tsv := "id\tname\tage\tscore\tactive\tjoined\tplan\n" +
+		"1\tbob\t20\t0.5\ttrue\t2018-01-02\tfree\n" +
+		"0\tbob\t20\t0.5\ttrue\t2018-01-02\tfree\n" +
+		"3\tcarol\t\t0\tfalse\t2018-01-04\tpro\n"
+
+	gr := New(bytes.NewBufferString(tsv), WithSchema(testSchema), WithHeader(), WithLenient(0))
+	var ids []uint64
+	var ages []*uint64
+	for gr.Next() {
+		ids = append(ids, gr.Uint64ByName("id"))
+		gr.Skip(1) // name
+		ages = append(ages, gr.Uint64Ptr())
+	}
+
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	age := uint64(20)
+	if !reflect.DeepEqual([]uint64{1, 3}, ids) || !reflect.DeepEqual([]*uint64{&age, nil}, ages) {
+		t.Fatalf("returned value check failed: %v %v", ids, ages)
+	}
+	if n := len(gr.Errors()); n != 1 {
+		t.Fatalf("%d errors are recorded", n)
+	}
+}
+
+func TestSchemaEmptyString(t *testing.T) {
+	s := Schema{Columns: []Column{
+		{Name: "note", Type: TypeString},
+		{Name: "plan", Type: TypeEnum, Values: []string{"free", "pro"}},
+		{Name: "memo", Type: TypeString, Nullable: true},
+	}}
+	tsv := "\tfree\t\n" +
+		"x\t\tmemo\n"
+
+	gr := New(bytes.NewBufferString(tsv), WithSchema(s), WithLenient(0))
+	var notes []string
+	var memos []*string
+	for gr.Next() {
+		notes = append(notes, gr.String()) // empty string isn't null unless nullable
+		gr.Skip(1)
+		memos = append(memos, gr.StringPtr())
+	}
+
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if !reflect.DeepEqual([]string{""}, notes) || !reflect.DeepEqual([]*string{nil}, memos) {
+		t.Fatalf("returned value check failed: %q %v", notes, memos)
+	}
+
+	// empty enum is checked against values
+	var ce *ConstraintError
+	if errs := gr.Errors(); len(errs) != 1 || !errors.As(errs[0], &ce) || ce.Constraint != "values" {
+		t.Fatalf("invalid errors %v", errs)
+	}
+}
+
+func TestSchemaIntegerBound(t *testing.T) {
+	tests := []struct {
+		name   string
+		column Column
+		tsv    string
+		valid  bool
+	}{
+		{name: "int at max", column: Column{Type: TypeInt, Max: float64Ptr(1 << 53)}, tsv: "9007199254740992\n", valid: true},
+		{name: "int over max", column: Column{Type: TypeInt, Max: float64Ptr(1 << 53)}, tsv: "9007199254740993\n"},
+		{name: "int under min", column: Column{Type: TypeInt, Min: float64Ptr(-1 << 53)}, tsv: "-9007199254740993\n"},
+		{name: "uint over max", column: Column{Type: TypeUint, Max: float64Ptr(1 << 53)}, tsv: "9007199254740993\n"},
+		{name: "fractional bound", column: Column{Type: TypeInt, Max: float64Ptr(1.5)}, tsv: "1\n", valid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), WithSchema(Schema{Columns: []Column{tt.column}}))
+			for gr.Next() {
+				gr.SkipRest()
+			}
+			if (gr.Error() == nil) != tt.valid {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if !tt.valid && !errors.Is(gr.Error(), ErrConstraint) {
+				t.Fatalf("invalid error %v", gr.Error())
+			}
+		})
+	}
+}
+
+func TestSchemaHeader(t *testing.T) {
+	s := Schema{Columns: []Column{{Name: "id", Type: TypeInt}, {Name: "name"}}}
+	gr := New(bytes.NewBufferString("id\tfull_name\n1\tbob\n"), WithSchema(s), WithHeader())
+	if gr.Next() || gr.Error() == nil {
+		t.Fatalf("header mismatch should be error")
+	}
+}
+
+func TestSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema Schema
+	}{
+		{name: "no columns", schema: Schema{}},
+		{name: "enum without values", schema: Schema{Columns: []Column{{Type: TypeEnum}}}},
+		{name: "unknown type", schema: Schema{Columns: []Column{{Type: ColumnType(100)}}}},
+		{name: "min greater than max", schema: Schema{Columns: []Column{{Type: TypeInt, Min: float64Ptr(1), Max: float64Ptr(0)}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString("1\n"), WithSchema(tt.schema))
+			if gr.Next() || gr.Error() == nil {
+				t.Fatalf("invalid schema should be error")
+			}
+		})
+	}
+}
+
+func TestParseColumnType(t *testing.T) {
+	for _, typ := range []ColumnType{TypeString, TypeInt, TypeUint, TypeUint8, TypeFloat64, TypeBool, TypeTime, TypeEnum} {
+		ret, err := ParseColumnType(typ.String())
+		if err != nil || ret != typ {
+			t.Fatalf("ParseColumnType(%q) returned %v, %v", typ.String(), ret, err)
+		}
+	}
+	if _, err := ParseColumnType("decimal"); err == nil {
+		t.Fatalf("unknown type should be error")
+	}
+}
@@ -0,0 +1,101 @@
+package gtsv
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ReadSchema reads Schema from r.
+// Each line declares a column, with name, type and constraints separated by tab.
+//
+//	# name	type	constraints...
+//	id	uint	min=1
+//	name	string	maxlen=32	pattern=^[a-z]+$
+//	age	uint8	nullable	max=150
+//	joined	time	layout=2006-01-02
+//	plan	enum	values=free,pro
+//
+// Types are the names of ColumnType, like "int".
+// Constraints are "nullable", "min=", "max=", "maxlen=", "pattern=", "values=" and "layout=".
+// values is separated by comma.
+// Column without "nullable" can't be null, except string and enum column, whose null value like empty string is read as text.
+// Lines starting with "#" and empty lines are skipped, and escape sequences are unescaped like TSV.
+func ReadSchema(r io.Reader) (Schema, error) {
+	var s Schema
+	gr := New(r, WithComment("#"), WithSkipEmptyLines(), WithRaggedRows())
+	for gr.Next() {
+		fields := gr.Strings()
+		line := gr.Position().Line
+		if len(fields) < 2 {
+			return Schema{}, fmt.Errorf("gtsv: schema line %d: name and type are required", line)
+		}
+
+		typ, err := ParseColumnType(fields[1])
+		if err != nil {
+			return Schema{}, fmt.Errorf("gtsv: schema line %d: unknown column type %q", line, fields[1])
+		}
+		c := Column{Name: fields[0], Type: typ}
+		for _, f := range fields[2:] {
+			if err := c.setConstraint(f); err != nil {
+				return Schema{}, fmt.Errorf("gtsv: schema line %d: %s", line, err)
+			}
+		}
+		s.Columns = append(s.Columns, c)
+	}
+	if err := gr.Error(); err != nil {
+		return Schema{}, err
+	}
+
+	if err := s.validate(); err != nil {
+		return Schema{}, err
+	}
+	return s, nil
+}
+
+// setConstraint sets constraint written like "min=1" into c.
+func (c *Column) setConstraint(s string) error {
+	if s == "nullable" {
+		c.Nullable = true
+		return nil
+	}
+
+	n := strings.IndexByte(s, '=')
+	if n < 0 {
+		return fmt.Errorf("unknown constraint %q", s)
+	}
+	key, value := s[:n], s[n+1:]
+	switch key {
+	case "min", "max":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%s must be number, but got %q", key, value)
+		}
+		if key == "min" {
+			c.Min = &f
+		} else {
+			c.Max = &f
+		}
+	case "maxlen":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("maxlen must be non-negative integer, but got %q", value)
+		}
+		c.MaxLen = n
+	case "pattern":
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return fmt.Errorf("invalid pattern: %s", err)
+		}
+		c.Pattern = re
+	case "values":
+		c.Values = strings.Split(value, ",")
+	case "layout":
+		c.Layout = value
+	default:
+		return fmt.Errorf("unknown constraint %q", s)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package gtsv
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestReadSchema(t *testing.T) {
+	src := "# name\ttype\tconstraints\n" +
+		"id\tuint\tmin=1\n" +
+		"name\tstring\tmaxlen=5\tpattern=^[a-z]+$\n" +
+		"\n" +
+		"age\tuint8\tnullable\tmax=150\n" +
+		"score\tfloat64\tmin=0\tmax=1\n" +
+		"active\tbool\n" +
+		"joined\ttime\tlayout=2006-01-02\n" +
+		"plan\tenum\tvalues=free,pro\n"
+
+	s, err := ReadSchema(bytes.NewBufferString(src))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if !reflect.DeepEqual(testSchema, s) {
+		t.Fatalf("returned value check failed expected: %+v, actual: %+v", testSchema, s)
+	}
+}
+
+func TestReadSchemaError(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{name: "no type", src: "id\n"},
+		{name: "unknown type", src: "id\tdecimal\n"},
+		{name: "unknown constraint", src: "id\tint\tunique\n"},
+		{name: "invalid min", src: "id\tint\tmin=one\n"},
+		{name: "invalid maxlen", src: "id\tstring\tmaxlen=-1\n"},
+		{name: "invalid pattern", src: "id\tstring\tpattern=[\n"},
+		{name: "enum without values", src: "plan\tenum\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadSchema(bytes.NewBufferString(tt.src)); err == nil {
+				t.Fatalf("invalid schema should be error")
+			}
+		})
+	}
+}
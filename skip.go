@@ -0,0 +1,19 @@
+package gtsv
+
+import (
+	"bytes"
+)
+
+// skipLine returns line should be skipped as comment line or empty line.
+func (gr *Reader) skipLine(line []byte) bool {
+	if len(gr.comment) > 0 && bytes.HasPrefix(line, gr.comment) {
+		return true
+	}
+	if !gr.skipEmpty {
+		return false
+	}
+	if len(line) == 1 && line[0] == '\r' && gr.terminator == '\n' && !gr.strictLF {
+		return true // empty line of CRLF
+	}
+	return len(line) == 0
+}
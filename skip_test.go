@@ -0,0 +1,118 @@
+package gtsv
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestSkipLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		opts     []Option
+		result   [][]int
+		hasError bool
+		errRow   int
+	}{
+		{
+			name: "comment",
+			tsv: "# fixture\n" +
+				"1\t2\n" +
+				"# 3\t4\n" +
+				"5\t6\n",
+			opts:   []Option{WithComment("#")},
+			result: [][]int{{1, 2}, {5, 6}},
+		},
+		{
+			name: "empty lines",
+			tsv: "\n" +
+				"1\t2\n" +
+				"\r\n" +
+				"\n" +
+				"5\t6\n",
+			opts:   []Option{WithSkipEmptyLines()},
+			result: [][]int{{1, 2}, {5, 6}},
+		},
+		{
+			name: "empty lines are rows by default",
+			tsv: "1\t2\n" +
+				"\n" +
+				"5\t6\n",
+			result:   [][]int{{1, 2}, {0, 0}},
+			hasError: true,
+			errRow:   2,
+		},
+		{
+			name: "error row is line number",
+			tsv: "# fixture\n" +
+				"\n" +
+				"1\t2\n" +
+				"#\n" +
+				"5\tx\n",
+			opts:     []Option{WithComment("#"), WithSkipEmptyLines()},
+			result:   [][]int{{1, 2}, {5, 0}},
+			hasError: true,
+			errRow:   5,
+		},
+		{
+			name: "error row with header",
+			tsv: "#c\n" +
+				"name\tage\n" +
+				"bob\tx\n",
+			opts:     []Option{WithComment("#"), WithHeader()},
+			result:   [][]int{{0, 0}},
+			hasError: true,
+			errRow:   3,
+		},
+		{
+			name: "comment with quote",
+			tsv: "# it's \"quoted\n" +
+				"1\t\"2\"\n",
+			opts:   []Option{WithComment("#"), WithQuote()},
+			result: [][]int{{1, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			var ret [][]int
+			for gr.Next() {
+				ret = append(ret, []int{gr.Int(), gr.Int()})
+			}
+
+			err := gr.Error()
+			if (err != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", err)
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+			if er, ok := err.(Error); ok && (er.Row() != tt.errRow || er.Position().Line != tt.errRow) {
+				t.Fatalf("invalid error tracer row: %d, line: %d", er.Row(), er.Position().Line)
+			}
+		})
+	}
+}
+
+func TestSkipLinesAfterQuotedRow(t *testing.T) {
+	tsv := "\"a\n" +
+		"b\"\t1\n" +
+		"# c\n" +
+		"d\tx\n"
+
+	gr := New(bytes.NewBufferString(tsv), WithComment("#"), WithQuote())
+	for gr.Next() {
+		gr.Bytes()
+		gr.Int()
+	}
+
+	er, ok := gr.Error().(Error)
+	if !ok {
+		t.Fatalf("invalid error %v", gr.Error())
+	}
+	if er.Row() != 4 || er.Position().Line != 4 || er.Position().Record != 2 {
+		t.Fatalf("invalid error tracer row: %d, position: %+v", er.Row(), er.Position())
+	}
+}
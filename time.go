@@ -0,0 +1,108 @@
+package gtsv
+
+import (
+	"strconv"
+	"time"
+)
+
+// Time returns next column as time.Time, parsed with layout.
+// If layout is empty, the layout passed to `WithTimeLayout()` is used.
+// Time without time zone is parsed in the location passed to `WithLocation()` ,
+// and returned time is in the location.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Time(layout string) time.Time {
+	b, ok := gr.column()
+	if !ok {
+		return time.Time{}
+	}
+
+	if layout == "" {
+		layout = gr.timeLayout
+	}
+	t, err := time.ParseInLocation(layout, string(gr.unescape(b)), gr.location) // error keeps the string, so copy it
+	if err != nil {
+		gr.failParse("time", b, err)
+		return time.Time{}
+	}
+	return t.In(gr.location)
+}
+
+// Unix returns next column, seconds since Unix epoch, as time.Time.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Unix() time.Time {
+	return gr.epoch("unix", time.Second)
+}
+
+// UnixMilli returns next column, milliseconds since Unix epoch, as time.Time.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) UnixMilli() time.Time {
+	return gr.epoch("unixmilli", time.Millisecond)
+}
+
+// UnixMicro returns next column, microseconds since Unix epoch, as time.Time.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) UnixMicro() time.Time {
+	return gr.epoch("unixmicro", time.Microsecond)
+}
+
+// UnixNano returns next column, nanoseconds since Unix epoch, as time.Time.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) UnixNano() time.Time {
+	return gr.epoch("unixnano", time.Nanosecond)
+}
+
+// epoch returns next column, a number of unit since Unix epoch, as time.Time.
+// typ is used as the type name of error.
+func (gr *Reader) epoch(typ string, unit time.Duration) time.Time {
+	b, ok := gr.column()
+	if !ok {
+		return time.Time{}
+	}
+
+	n, err := strconv.ParseInt(bytesToString(b), 10, 64)
+	if err != nil {
+		gr.failParse(typ, b, err)
+		return time.Time{}
+	}
+	per := int64(time.Second / unit)
+	sec, nsec := n/per, n%per*int64(unit)
+	if nsec < 0 {
+		sec, nsec = sec-1, nsec+int64(time.Second)
+	}
+	return time.Unix(sec, nsec).In(gr.location)
+}
+
+// Duration returns next column as time.Duration.
+// It uses `time.ParseDuration()` inside, so can read like "1h30m" or "250ms".
+// If error had happened, it always returns zero-value.
+func (gr *Reader) Duration() time.Duration {
+	b, ok := gr.column()
+	if !ok {
+		return 0
+	}
+
+	d, err := time.ParseDuration(string(b)) // error keeps the string, so copy it
+	if err != nil {
+		gr.failParse("duration", b, err)
+		return 0
+	}
+	return d
+}
+
+// TimeByName returns the column named name as time.Time, parsed like `Time()` .
+// If error had happened, it always returns zero-value.
+func (gr *Reader) TimeByName(name, layout string) time.Time {
+	if !gr.seek(name) {
+		return time.Time{}
+	}
+	return gr.Time(layout)
+}
+
+// DurationByName returns the column named name as time.Duration.
+// If error had happened, it always returns zero-value.
+func (gr *Reader) DurationByName(name string) time.Duration {
+	if !gr.seek(name) {
+		return 0
+	}
+	return gr.Duration()
+}
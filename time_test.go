@@ -0,0 +1,143 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		name     string
+		tsv      string
+		layout   string
+		opts     []Option
+		result   time.Time
+		hasError bool
+	}{
+		{
+			name:   "layout",
+			tsv:    "2018-01-02 03:04:05\n",
+			layout: "2006-01-02 15:04:05",
+			result: time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			name:   "default layout",
+			tsv:    "2018-01-02T03:04:05Z\n",
+			result: time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			name:   "custom default layout",
+			tsv:    "2018/01/02\n",
+			opts:   []Option{WithTimeLayout("2006/01/02")},
+			result: time.Date(2018, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "location",
+			tsv:    "2018-01-02 03:04:05\n",
+			layout: "2006-01-02 15:04:05",
+			opts:   []Option{WithLocation(jst)},
+			result: time.Date(2018, 1, 2, 3, 4, 5, 0, jst),
+		},
+		{
+			name:   "converted into location",
+			tsv:    "2018-01-02T03:04:05Z\n",
+			opts:   []Option{WithLocation(jst)},
+			result: time.Date(2018, 1, 2, 12, 4, 5, 0, jst),
+		},
+		{
+			name:     "invalid",
+			tsv:      "2018-13-02\n",
+			layout:   "2006-01-02",
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			var ret time.Time
+			for gr.Next() {
+				ret = gr.Time(tt.layout)
+			}
+
+			if (gr.Error() != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if !ret.Equal(tt.result) || ret.Location().String() != tt.result.Location().String() {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+		})
+	}
+}
+
+func TestUnix(t *testing.T) {
+	want := time.Date(2018, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	tsv := "1514862245\t1514862245123\t1514862245123456\t1514862245123456789\t-1\n"
+
+	gr := New(bytes.NewBufferString(tsv))
+	for gr.Next() {
+		if v := gr.Unix(); !v.Equal(want.Truncate(time.Second)) {
+			t.Fatalf("Unix() returned %v", v)
+		}
+		if v := gr.UnixMilli(); !v.Equal(want.Truncate(time.Millisecond)) {
+			t.Fatalf("UnixMilli() returned %v", v)
+		}
+		if v := gr.UnixMicro(); !v.Equal(want.Truncate(time.Microsecond)) {
+			t.Fatalf("UnixMicro() returned %v", v)
+		}
+		if v := gr.UnixNano(); !v.Equal(want) {
+			t.Fatalf("UnixNano() returned %v", v)
+		}
+		if v := gr.UnixMilli(); !v.Equal(time.Unix(0, -int64(time.Millisecond))) {
+			t.Fatalf("negative UnixMilli() returned %v", v)
+		}
+	}
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+}
+
+func TestDuration(t *testing.T) {
+	gr := New(bytes.NewBufferString("1h30m\t250ms\n3 days\n"))
+	gr.Next()
+	if d := gr.Duration(); d != 90*time.Minute {
+		t.Fatalf("returned value check failed: %v", d)
+	}
+	if d := gr.Duration(); d != 250*time.Millisecond {
+		t.Fatalf("returned value check failed: %v", d)
+	}
+
+	gr.Next()
+	if d := gr.Duration(); d != 0 {
+		t.Fatalf("invalid duration should be zero: %v", d)
+	}
+	var e Error
+	if !errors.As(gr.Error(), &e) || e.Row() != 2 || e.Col() != 1 || e.Type() != "duration" || !errors.Is(gr.Error(), ErrSyntax) {
+		t.Fatalf("error check failed: %v", gr.Error())
+	}
+}
+
+func TestTimeByName(t *testing.T) {
+	gr := New(bytes.NewBufferString("timeout\tat\n5s\t2018-01-02\n"), WithHeader())
+	for gr.Next() {
+		if v := gr.TimeByName("at", "2006-01-02"); !v.Equal(time.Date(2018, 1, 2, 0, 0, 0, 0, time.UTC)) {
+			t.Fatalf("returned value check failed: %v", v)
+		}
+		if v := gr.DurationByName("timeout"); v != 5*time.Second {
+			t.Fatalf("returned value check failed: %v", v)
+		}
+	}
+	if err := gr.Error(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+}
+
+func TestNilLocation(t *testing.T) {
+	gr := New(bytes.NewBufferString("2018-01-02T03:04:05Z\n"), WithLocation(nil))
+	if gr.Next() || gr.Error() == nil {
+		t.Fatalf("nil location should be error")
+	}
+}
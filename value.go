@@ -0,0 +1,92 @@
+package gtsv
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// ParseFunc parses column into the value of registered type.
+// b is unescaped column, and it will be overwritten by next `Next()` , so copy it to keep.
+// If returned error wraps strconv.ErrRange, the error kind is ErrRange, otherwise ErrSyntax.
+type ParseFunc func(b []byte) (interface{}, error)
+
+// Value reads next column into dst with `UnmarshalText()` .
+// Passed column is unescaped.
+// If error had happened, dst is not modified.
+func (gr *Reader) Value(dst encoding.TextUnmarshaler) {
+	b, ok := gr.column()
+	if !ok {
+		return
+	}
+
+	if err := dst.UnmarshalText(gr.unescape(b)); err != nil {
+		gr.failParse(typeName(reflect.TypeOf(dst)), b, err)
+	}
+}
+
+// Parse reads next column into dst with the parser registered by `WithParser()` .
+// dst must be a pointer to registered type.
+// If error had happened, dst is not modified.
+func (gr *Reader) Parse(dst interface{}) {
+	if gr.err != nil {
+		return
+	}
+
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		gr.err = fmt.Errorf("gtsv: Parse needs non-nil pointer, but got %T", dst)
+		return
+	}
+	fn, ok := gr.parsers[rv.Type().Elem()]
+	if !ok {
+		gr.err = fmt.Errorf("gtsv: no parser is registered for %s", rv.Type().Elem())
+		return
+	}
+	gr.parse(rv.Elem(), fn)
+}
+
+// parse reads next column into rv with fn.
+func (gr *Reader) parse(rv reflect.Value, fn ParseFunc) {
+	b, ok := gr.column()
+	if !ok {
+		return
+	}
+
+	v, err := fn(gr.unescape(b))
+	if err != nil {
+		gr.failParse(typeName(rv.Type()), b, err)
+		return
+	}
+	pv := reflect.ValueOf(v)
+	if !pv.IsValid() || pv.Type() != rv.Type() {
+		gr.err = fmt.Errorf("gtsv: parser for %s returned %T", rv.Type(), v)
+		return
+	}
+	rv.Set(pv)
+}
+
+// ValueByName reads the column named name into dst like `Value()` .
+func (gr *Reader) ValueByName(name string, dst encoding.TextUnmarshaler) {
+	if !gr.seek(name) {
+		return
+	}
+	gr.Value(dst)
+}
+
+// ParseByName reads the column named name into dst like `Parse()` .
+func (gr *Reader) ParseByName(name string, dst interface{}) {
+	if !gr.seek(name) {
+		return
+	}
+	gr.Parse(dst)
+}
+
+// typeName returns the name of typ used as `gtsv.Error.Type()` .
+// Pointer is dereferenced, because dst of `Value()` is usually a pointer.
+func typeName(typ reflect.Type) string {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.String()
+}
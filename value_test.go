@@ -0,0 +1,152 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type currency string
+
+func (c *currency) UnmarshalText(b []byte) error {
+	if len(b) != 3 || strings.ToUpper(string(b)) != string(b) {
+		return fmt.Errorf("invalid currency code %q", b)
+	}
+	*c = currency(b)
+	return nil
+}
+
+type level int
+
+func parseLevel(b []byte) (interface{}, error) {
+	switch string(b) {
+	case "low":
+		return level(1), nil
+	case "high":
+		return level(2), nil
+	}
+	return nil, fmt.Errorf("unknown level %q", b)
+}
+
+func TestValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		result   []currency
+		errCol   int
+		hasError bool
+	}{
+		{
+			name:   "valid",
+			tsv:    "USD\tJPY\n",
+			result: []currency{"USD", "JPY"},
+		},
+		{
+			name:     "invalid",
+			tsv:      "USD\tyen\n",
+			result:   []currency{"USD", ""},
+			errCol:   2,
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv))
+			var ret []currency
+			for gr.Next() {
+				var a, b currency
+				gr.Value(&a)
+				gr.Value(&b)
+				ret = append(ret, a, b)
+			}
+
+			if (gr.Error() != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if tt.hasError {
+				var e Error
+				if !errors.As(gr.Error(), &e) || e.Col() != tt.errCol || e.Type() != "gtsv.currency" || !errors.Is(gr.Error(), ErrSyntax) {
+					t.Fatalf("error check failed: %v", gr.Error())
+				}
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		tsv      string
+		opts     []Option
+		result   []level
+		hasError bool
+	}{
+		{
+			name:   "registered",
+			tsv:    "low\nhigh\n",
+			opts:   []Option{WithParser(level(0), parseLevel)},
+			result: []level{1, 2},
+		},
+		{
+			name:     "parse error",
+			tsv:      "low\nmiddle\n",
+			opts:     []Option{WithParser(level(0), parseLevel)},
+			result:   []level{1, 0},
+			hasError: true,
+		},
+		{
+			name:     "not registered",
+			tsv:      "low\n",
+			result:   []level{0},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr := New(bytes.NewBufferString(tt.tsv), tt.opts...)
+			var ret []level
+			for gr.Next() {
+				var l level
+				gr.Parse(&l)
+				ret = append(ret, l)
+			}
+
+			if (gr.Error() != nil) != tt.hasError {
+				t.Fatalf("error check failed: %v", gr.Error())
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %v, actual: %v", tt.result, ret)
+			}
+		})
+	}
+}
+
+func TestDecodeCustomType(t *testing.T) {
+	type order struct {
+		Price    int      `tsv:"price"`
+		Currency currency `tsv:"currency"`
+		Level    level    `tsv:"level"`
+	}
+
+	tsv := "level\tprice\tcurrency\nhigh\t100\tUSD\n"
+	var ret []order
+	if err := Unmarshal([]byte(tsv), &ret, WithHeader(), WithParser(level(0), parseLevel)); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	expected := []order{{Price: 100, Currency: "USD", Level: 2}}
+	if !reflect.DeepEqual(expected, ret) {
+		t.Fatalf("returned value check failed expected: %v, actual: %v", expected, ret)
+	}
+
+	if err := Unmarshal([]byte(tsv), &ret, WithHeader()); err == nil {
+		t.Fatalf("unregistered type should be error")
+	}
+}
@@ -0,0 +1,182 @@
+package gtsv
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+)
+
+// Writer writes TSV formatted text.
+// It is symmetric to Reader, each column is written type-specifically
+// and a row is terminated by `EndRow()` .
+// Output is buffered, so `Flush()` must be called after writing.
+type Writer struct {
+	writer *bufio.Writer
+	buff   []byte // temporary buffer which stores formatted column
+	col    int
+	row    int
+	err    error
+}
+
+// NewWriter returns new TSV writer which writes to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{writer: bufio.NewWriter(w), row: 1}
+}
+
+// Error returns TSV writing error.
+// If it is not nil, it implements `gtsv.Error` and
+// Row() and Col() show the position which was being written.
+// Once error had happened, all writing methods do nothing.
+func (gw *Writer) Error() error {
+	return gw.err
+}
+
+// Flush writes buffered data to underlying io.Writer.
+// It returns the same error as `Error()` .
+// Buffered data may span rows, so the error of flushing has no position, Row() and Col() return 0.
+func (gw *Writer) Flush() error {
+	if gw.err != nil {
+		return gw.err
+	}
+	if err := gw.writer.Flush(); err != nil {
+		gw.err = &gtsverror{kind: ErrIO, err: err, pos: Position{FieldOffset: -1}, op: "Write"}
+	}
+	return gw.err
+}
+
+// EndRow terminates current row.
+func (gw *Writer) EndRow() {
+	if gw.err != nil {
+		return
+	}
+	if err := gw.writer.WriteByte('\n'); err != nil {
+		gw.err = gw.newError(err)
+		return
+	}
+	gw.col = 0
+	gw.row++
+}
+
+// WriteInt writes n as next column.
+func (gw *Writer) WriteInt(n int) {
+	gw.writeNumber(strconv.AppendInt(gw.buff[:0], int64(n), 10))
+}
+
+// WriteUint writes n as next column.
+func (gw *Writer) WriteUint(n uint) {
+	gw.writeNumber(strconv.AppendUint(gw.buff[:0], uint64(n), 10))
+}
+
+// WriteInt8 writes n as next column.
+func (gw *Writer) WriteInt8(n int8) {
+	gw.writeNumber(strconv.AppendInt(gw.buff[:0], int64(n), 10))
+}
+
+// WriteUint8 writes n as next column.
+func (gw *Writer) WriteUint8(n uint8) {
+	gw.writeNumber(strconv.AppendUint(gw.buff[:0], uint64(n), 10))
+}
+
+// WriteInt16 writes n as next column.
+func (gw *Writer) WriteInt16(n int16) {
+	gw.writeNumber(strconv.AppendInt(gw.buff[:0], int64(n), 10))
+}
+
+// WriteUint16 writes n as next column.
+func (gw *Writer) WriteUint16(n uint16) {
+	gw.writeNumber(strconv.AppendUint(gw.buff[:0], uint64(n), 10))
+}
+
+// WriteInt32 writes n as next column.
+func (gw *Writer) WriteInt32(n int32) {
+	gw.writeNumber(strconv.AppendInt(gw.buff[:0], int64(n), 10))
+}
+
+// WriteUint32 writes n as next column.
+func (gw *Writer) WriteUint32(n uint32) {
+	gw.writeNumber(strconv.AppendUint(gw.buff[:0], uint64(n), 10))
+}
+
+// WriteInt64 writes n as next column.
+func (gw *Writer) WriteInt64(n int64) {
+	gw.writeNumber(strconv.AppendInt(gw.buff[:0], n, 10))
+}
+
+// WriteUint64 writes n as next column.
+func (gw *Writer) WriteUint64(n uint64) {
+	gw.writeNumber(strconv.AppendUint(gw.buff[:0], n, 10))
+}
+
+// WriteFloat32 writes f as next column.
+// It is formatted in the shortest representation which `Reader.Float32()` reads back identically.
+func (gw *Writer) WriteFloat32(f float32) {
+	gw.writeNumber(strconv.AppendFloat(gw.buff[:0], float64(f), 'g', -1, 32))
+}
+
+// WriteFloat64 writes f as next column.
+// It is formatted in the shortest representation which `Reader.Float64()` reads back identically.
+func (gw *Writer) WriteFloat64(f float64) {
+	gw.writeNumber(strconv.AppendFloat(gw.buff[:0], f, 'g', -1, 64))
+}
+
+// WriteBool writes b as next column, "true" or "false".
+func (gw *Writer) WriteBool(b bool) {
+	gw.writeNumber(strconv.AppendBool(gw.buff[:0], b))
+}
+
+// WriteString writes s as next column.
+// Characters which `Reader.Bytes()` unescapes are escaped.
+func (gw *Writer) WriteString(s string) {
+	gw.WriteBytes([]byte(s))
+}
+
+// WriteBytes writes b as next column.
+// Characters which `Reader.Bytes()` unescapes are escaped.
+func (gw *Writer) WriteBytes(b []byte) {
+	gw.buff = appendEscaped(gw.buff[:0], b)
+	if !gw.startColumn() {
+		return
+	}
+	if _, err := gw.writer.Write(gw.buff); err != nil {
+		gw.err = gw.newError(err)
+	}
+}
+
+// writeNumber writes b as next column without escaping.
+func (gw *Writer) writeNumber(b []byte) {
+	gw.buff = b // keep grown buffer
+	if !gw.startColumn() {
+		return
+	}
+	if _, err := gw.writer.Write(b); err != nil {
+		gw.err = gw.newError(err)
+	}
+}
+
+// startColumn writes delimiter if needed.
+func (gw *Writer) startColumn() bool {
+	if gw.err != nil {
+		return false
+	}
+	gw.col++
+	if gw.col == 1 {
+		return true
+	}
+	if err := gw.writer.WriteByte('\t'); err != nil {
+		gw.err = gw.newError(err)
+		return false
+	}
+	return true
+}
+
+// newError returns the error which happened while writing current column.
+func (gw *Writer) newError(cause error) *gtsverror {
+	pos := Position{Record: gw.row, Line: gw.row, FieldOffset: -1}
+	return &gtsverror{row: gw.row, col: gw.col, kind: ErrIO, err: cause, pos: pos, op: "Write"}
+}
+
+// newMarshalError returns the error which happened while marshaling next column as typ.
+func (gw *Writer) newMarshalError(typ string, cause error) *gtsverror {
+	pos := Position{Record: gw.row, Line: gw.row, FieldOffset: -1}
+	return &gtsverror{row: gw.row, col: gw.col + 1, typ: typ, err: cause, pos: pos, op: "Write"}
+}
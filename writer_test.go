@@ -0,0 +1,136 @@
+package gtsv
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	gw := NewWriter(&buf)
+
+	gw.WriteInt(-1)
+	gw.WriteUint8(255)
+	gw.WriteInt64(math.MinInt64)
+	gw.WriteUint64(math.MaxUint64)
+	gw.WriteFloat32(1.2345679)
+	gw.WriteFloat64(1.23456789012345)
+	gw.WriteBool(true)
+	gw.WriteString("a\tb")
+	gw.EndRow()
+	if err := gw.Flush(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	expected := "-1\t255\t-9223372036854775808\t18446744073709551615\t1.2345679\t1.23456789012345\ttrue\ta\\tb\n"
+	if buf.String() != expected {
+		t.Fatalf("written value check failed expected: %q, actual: %q", expected, buf.String())
+	}
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		result [][]string
+	}{
+		{
+			name:   "plain",
+			result: [][]string{{"aaa", "bbb"}, {"ccc", "ddd"}},
+		},
+		{
+			name:   "empty",
+			result: [][]string{{""}, {"", ""}},
+		},
+		{
+			name: "needs escaping",
+			result: [][]string{
+				{"a\tb", "c\nd", "e\\f"},
+				{"\b\f\r\n\t\x00'\\", "\\", "\\t"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			gw := NewWriter(&buf)
+			for _, line := range tt.result {
+				for _, s := range line {
+					gw.WriteString(s)
+				}
+				gw.EndRow()
+			}
+			if err := gw.Flush(); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+
+			gr := New(&buf)
+			var ret [][]string
+			for i := 0; gr.Next(); i++ {
+				var line []string
+				for range tt.result[i] {
+					line = append(line, gr.String())
+				}
+				ret = append(ret, line)
+			}
+
+			if err := gr.Error(); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if !reflect.DeepEqual(tt.result, ret) {
+				t.Fatalf("returned value check failed expected: %q, actual: %q", tt.result, ret)
+			}
+		})
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriterError(t *testing.T) {
+	gw := NewWriter(errWriter{})
+	gw.WriteInt(1)
+	gw.EndRow()
+	gw.WriteString(string(make([]byte, 8<<10))) // larger than buffer
+	gw.WriteInt(2)
+
+	err := gw.Flush()
+	if err == nil {
+		t.Fatalf("error should be returned")
+	}
+
+	er, ok := err.(Error)
+	if !ok {
+		t.Fatalf("invalid error %s", err)
+	}
+	if er.Row() != 2 || er.Col() != 1 {
+		t.Fatalf("invalid error tracer row: %d, col: %d", er.Row(), er.Col())
+	}
+	if expected := "Write failed at row #2, col #1: write failed"; err.Error() != expected {
+		t.Fatalf("error message check failed expected: %q, actual: %q", expected, err.Error())
+	}
+}
+
+func TestWriterFlushError(t *testing.T) {
+	gw := NewWriter(errWriter{})
+	gw.WriteInt(1)
+	gw.EndRow()
+
+	err := gw.Flush()
+	er, ok := err.(Error)
+	if !ok || !errors.Is(err, ErrIO) {
+		t.Fatalf("invalid error %v", err)
+	}
+	if er.Row() != 0 || er.Col() != 0 {
+		t.Fatalf("flush error should have no position row: %d, col: %d", er.Row(), er.Col())
+	}
+	if expected := "Write failed: write failed"; err.Error() != expected {
+		t.Fatalf("error message check failed expected: %q, actual: %q", expected, err.Error())
+	}
+}